@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AppRoleParameters are the configurable fields of an AppRole.
+type AppRoleParameters struct {
+	// The path the AppRole auth backend is mounted at, with no leading or trailing /s. Defaults to approle.
+	// +optional
+	// +kubebuilder:default:=approle
+	Backend *string `json:"backend,omitempty"`
+
+	// Require secret_id to be presented when logging in using this AppRole. Defaults to true.
+	// When false, at least one of secretIDBoundCIDRs or tokenBoundCIDRs must be set.
+	// +optional
+	BindSecretID *bool `json:"bindSecretID,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses which can perform the login operation.
+	// +optional
+	SecretIDBoundCIDRs []string `json:"secretIDBoundCIDRs,omitempty"`
+
+	// Number of times any particular SecretID can be used to fetch a token from this AppRole,
+	// after which the SecretID will expire. A value of zero will allow unlimited uses.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	SecretIDNumUses *int `json:"secretIDNumUses,omitempty"`
+
+	// Duration in seconds after which the issued SecretID should expire. A value of zero will allow the SecretID to not expire.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	SecretIDTTL *int `json:"secretIDTTL,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// AppRoleObservation are the observable fields of an AppRole.
+type AppRoleObservation struct {
+	// RoleID is the identifier of the AppRole, used along with a secret ID to log in.
+	RoleID string `json:"roleID,omitempty"`
+}
+
+// An AppRoleSpec defines the desired state of an AppRole.
+type AppRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppRoleParameters `json:"forProvider"`
+}
+
+// An AppRoleStatus represents the observed state of an AppRole.
+type AppRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AppRole is a role of an AppRole auth backend, which machines and
+// services log in to with its role ID and one of its secret IDs.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ROLE-ID",type="string",JSONPath=".status.atProvider.roleID"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AppRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppRoleSpec   `json:"spec"`
+	Status AppRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppRoleList contains a list of AppRole
+type AppRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppRole `json:"items"`
+}
+
+// AppRole type metadata.
+var (
+	AppRoleKind             = reflect.TypeOf(AppRole{}).Name()
+	AppRoleGroupKind        = schema.GroupKind{Group: Group, Kind: AppRoleKind}.String()
+	AppRoleKindAPIVersion   = AppRoleKind + "." + SchemeGroupVersion.String()
+	AppRoleGroupVersionKind = SchemeGroupVersion.WithKind(AppRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AppRole{}, &AppRoleList{})
+}
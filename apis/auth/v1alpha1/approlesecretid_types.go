@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AppRoleSecretIDParameters are the configurable fields of an AppRoleSecretID.
+// A secret ID cannot be changed once generated, so the parameters only apply
+// to the secret IDs generated after they change.
+type AppRoleSecretIDParameters struct {
+	// The path the AppRole auth backend is mounted at, with no leading or trailing /s. Defaults to approle.
+	// +optional
+	// +kubebuilder:default:=approle
+	Backend *string `json:"backend,omitempty"`
+
+	// The name of the AppRole to generate the secret ID for.
+	// +required
+	RoleName string `json:"roleName"`
+
+	// Metadata to be tied to the secret ID. It is logged in the audit logs in plaintext
+	// and attached to the tokens issued with the secret ID.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// List of CIDR blocks enforcing secret IDs to be used from specific sets of IP addresses.
+	// If secretIDBoundCIDRs is set on the AppRole, this must be a subset of it.
+	// +optional
+	CIDRList []string `json:"cidrList,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses which can use the tokens issued with this secret ID.
+	// If tokenBoundCIDRs is set on the AppRole, this must be a subset of it.
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+
+	// Duration in seconds after which the secret ID expires, overriding secretIDTTL of the AppRole.
+	// A new secret ID is generated once it expires.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	TTL *int `json:"ttl,omitempty"`
+
+	// Number of times the secret ID can be used, overriding secretIDNumUses of the AppRole.
+	// +optional
+	// +kubebuilder:validation:Minimum:=0
+	NumUses *int `json:"numUses,omitempty"`
+}
+
+// AppRoleSecretIDObservation are the observable fields of an AppRoleSecretID.
+type AppRoleSecretIDObservation struct {
+	// CreationTime is when the secret ID was generated.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// ExpirationTime is when the secret ID expires, if it does.
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// SecretIDNumUses is the number of uses left for the secret ID, zero meaning unlimited.
+	SecretIDNumUses int `json:"secretIDNumUses,omitempty"`
+}
+
+// An AppRoleSecretIDSpec defines the desired state of an AppRoleSecretID.
+type AppRoleSecretIDSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AppRoleSecretIDParameters `json:"forProvider"`
+}
+
+// An AppRoleSecretIDStatus represents the observed state of an AppRoleSecretID.
+type AppRoleSecretIDStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AppRoleSecretIDObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AppRoleSecretID is a secret ID generated for an AppRole. The secret ID,
+// its accessor and the role ID are published as connection details. A new
+// secret ID is generated when the current one expires or is destroyed. The
+// external name of an AppRoleSecretID is the accessor of its secret ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.roleName"
+// +kubebuilder:printcolumn:name="EXPIRATION-TIME",type="string",JSONPath=".status.atProvider.expirationTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AppRoleSecretID struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppRoleSecretIDSpec   `json:"spec"`
+	Status AppRoleSecretIDStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppRoleSecretIDList contains a list of AppRoleSecretID
+type AppRoleSecretIDList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppRoleSecretID `json:"items"`
+}
+
+// AppRoleSecretID type metadata.
+var (
+	AppRoleSecretIDKind             = reflect.TypeOf(AppRoleSecretID{}).Name()
+	AppRoleSecretIDGroupKind        = schema.GroupKind{Group: Group, Kind: AppRoleSecretIDKind}.String()
+	AppRoleSecretIDKindAPIVersion   = AppRoleSecretIDKind + "." + SchemeGroupVersion.String()
+	AppRoleSecretIDGroupVersionKind = SchemeGroupVersion.WithKind(AppRoleSecretIDKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AppRoleSecretID{}, &AppRoleSecretIDList{})
+}
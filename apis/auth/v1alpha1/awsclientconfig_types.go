@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AWSClientConfigParameters are the configurable fields of an AWSClientConfig.
+type AWSClientConfigParameters struct {
+	// The path the AWS auth backend is mounted at, with no leading or trailing /s. Defaults to aws.
+	// +optional
+	// +kubebuilder:default:=aws
+	Backend *string `json:"backend,omitempty"`
+
+	// A reference to the key of a Secret holding the AWS access key ID vault uses to call AWS. When
+	// unset, vault falls back to the credentials of its environment, such as an instance profile.
+	// +optional
+	AccessKeySecretRef *xpv1.SecretKeySelector `json:"accessKeySecretRef,omitempty"`
+
+	// A reference to the key of a Secret holding the AWS secret access key matching the access key.
+	// Vault does not return the secret key, so changes to the keys are detected through their hash, recorded in the status.
+	// +optional
+	SecretKeySecretRef *xpv1.SecretKeySelector `json:"secretKeySecretRef,omitempty"`
+
+	// URL to override the default generated endpoint for making AWS EC2 API calls.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// URL to override the default generated endpoint for making AWS IAM API calls.
+	// +optional
+	IAMEndpoint *string `json:"iamEndpoint,omitempty"`
+
+	// URL to override the default generated endpoint for making AWS STS API calls.
+	// +optional
+	STSEndpoint *string `json:"stsEndpoint,omitempty"`
+
+	// Region to override the default region for making AWS STS API calls. Should only be set
+	// along with stsEndpoint.
+	// +optional
+	STSRegion *string `json:"stsRegion,omitempty"`
+
+	// The value to require in the X-Vault-AWS-IAM-Server-ID header as part of GetCallerIdentity
+	// requests that are used in the iam auth method, mitigating replay attacks.
+	// +optional
+	IAMServerIDHeaderValue *string `json:"iamServerIDHeaderValue,omitempty"`
+
+	// Number of max retries the client should use for recoverable errors. The default, -1, falls
+	// back to the AWS SDK default.
+	// +optional
+	MaxRetries *int `json:"maxRetries,omitempty"`
+}
+
+// AWSClientConfigObservation are the observable fields of an AWSClientConfig.
+type AWSClientConfigObservation struct {
+	// A keyed hash of the access and secret keys last written to vault. Vault does not return the secret key,
+	// so a change of the referenced Secrets is noticed against this hash.
+	KeysHash string `json:"keysHash,omitempty"`
+}
+
+// An AWSClientConfigSpec defines the desired state of an AWSClientConfig.
+type AWSClientConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AWSClientConfigParameters `json:"forProvider"`
+}
+
+// An AWSClientConfigStatus represents the observed state of an AWSClientConfig.
+type AWSClientConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AWSClientConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AWSClientConfig is the client configuration of an AWS auth backend, read
+// and written at auth/<backend>/config/client. It sets the credentials and
+// endpoints vault uses to verify logins against AWS.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AWSClientConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSClientConfigSpec   `json:"spec"`
+	Status AWSClientConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSClientConfigList contains a list of AWSClientConfig
+type AWSClientConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSClientConfig `json:"items"`
+}
+
+// AWSClientConfig type metadata.
+var (
+	AWSClientConfigKind             = reflect.TypeOf(AWSClientConfig{}).Name()
+	AWSClientConfigGroupKind        = schema.GroupKind{Group: Group, Kind: AWSClientConfigKind}.String()
+	AWSClientConfigKindAPIVersion   = AWSClientConfigKind + "." + SchemeGroupVersion.String()
+	AWSClientConfigGroupVersionKind = SchemeGroupVersion.WithKind(AWSClientConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AWSClientConfig{}, &AWSClientConfigList{})
+}
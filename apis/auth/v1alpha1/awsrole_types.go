@@ -0,0 +1,251 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Validation Errors
+	errUnknownAuthType      = "auth_type must be one of iam or ec2"
+	errIAMOnly              = "bound_iam_principal_arn, inferred_entity_type, inferred_aws_region and resolve_aws_unique_ids are only valid when auth_type is iam"
+	errEC2Only              = "role_tag, allow_instance_migration and disallow_reauthentication are only valid when auth_type is ec2"
+	errInferredRegion       = "inferred_aws_region is only valid, and then required, when inferred_entity_type is set"
+	errEC2BoundsNotInferred = "ec2 bounds are only valid with the iam auth_type when inferred_entity_type is ec2_instance"
+	errIAMMinRequirements   = "at least one of: `bound_iam_principal_arn` or an ec2 bound with inferred_entity_type must be set"
+	errEC2MinRequirements   = "at least one ec2 bound must be set"
+
+	authTypeIAM = "iam"
+	authTypeEC2 = "ec2"
+)
+
+// AWSRoleParameters are the configurable fields of an AWSRole.
+type AWSRoleParameters struct {
+	// The path the AWS auth backend is mounted at, with no leading or trailing /s. Defaults to aws.
+	// +optional
+	// +kubebuilder:default:=aws
+	Backend *string `json:"backend,omitempty"`
+
+	// The auth type permitted for this role, either iam or ec2. Defaults to iam. It cannot be
+	// changed once the role is created.
+	// +optional
+	// +kubebuilder:validation:Enum:=iam;ec2
+	AuthType *string `json:"authType,omitempty"`
+
+	// The IAM principals allowed to log in with the iam auth type. Wildcards are supported at the
+	// end of the ARN, such as arn:aws:iam::123456789012:role/ci-*. Only valid for the iam auth type.
+	// +optional
+	BoundIAMPrincipalARN []string `json:"boundIAMPrincipalARN,omitempty"`
+
+	// The type of entity iam logins are inferred to be. Set it to ec2_instance to apply the ec2
+	// bounds to iam logins. Only valid for the iam auth type.
+	// +optional
+	// +kubebuilder:validation:Enum:=ec2_instance
+	InferredEntityType *string `json:"inferredEntityType,omitempty"`
+
+	// The region to search for the inferred entities in. Required along with inferredEntityType.
+	// +optional
+	InferredAWSRegion *string `json:"inferredAWSRegion,omitempty"`
+
+	// When true, bound IAM principals are resolved to their unique IDs, so that a deleted and
+	// recreated principal is not allowed to log in. Defaults to true. Only valid for the iam auth type.
+	// +optional
+	ResolveAWSUniqueIDs *bool `json:"resolveAWSUniqueIDs,omitempty"`
+
+	// The AMI IDs EC2 instances must have been launched from.
+	// +optional
+	BoundAMIID []string `json:"boundAMIID,omitempty"`
+
+	// The account IDs EC2 instances must belong to.
+	// +optional
+	BoundAccountID []string `json:"boundAccountID,omitempty"`
+
+	// The regions EC2 instances must run in.
+	// +optional
+	BoundRegion []string `json:"boundRegion,omitempty"`
+
+	// The VPC IDs EC2 instances must run in.
+	// +optional
+	BoundVPCID []string `json:"boundVPCID,omitempty"`
+
+	// The subnet IDs EC2 instances must run in.
+	// +optional
+	BoundSubnetID []string `json:"boundSubnetID,omitempty"`
+
+	// The ARNs of the IAM roles EC2 instances must have, through their instance profile.
+	// +optional
+	BoundIAMRoleARN []string `json:"boundIAMRoleARN,omitempty"`
+
+	// The ARNs of the instance profiles EC2 instances must have.
+	// +optional
+	BoundIAMInstanceProfileARN []string `json:"boundIAMInstanceProfileARN,omitempty"`
+
+	// The IDs of the EC2 instances allowed to log in.
+	// +optional
+	BoundEC2InstanceID []string `json:"boundEC2InstanceID,omitempty"`
+
+	// The key of the tag EC2 instances must carry a role tag in. Only valid for the ec2 auth type.
+	// +optional
+	RoleTag *string `json:"roleTag,omitempty"`
+
+	// If true, allows migration of the underlying instance where the client resides. Only valid
+	// for the ec2 auth type.
+	// +optional
+	AllowInstanceMigration *bool `json:"allowInstanceMigration,omitempty"`
+
+	// If true, only allows a single token to be granted per instance ID. Only valid for the ec2
+	// auth type.
+	// +optional
+	DisallowReauthentication *bool `json:"disallowReauthentication,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// AWSRoleObservation are the observable fields of an AWSRole.
+type AWSRoleObservation struct {
+}
+
+// An AWSRoleSpec defines the desired state of an AWSRole.
+type AWSRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AWSRoleParameters `json:"forProvider"`
+}
+
+// An AWSRoleStatus represents the observed state of an AWSRole.
+type AWSRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AWSRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AWSRole is a role of an AWS auth backend, named after the external name,
+// binding the AWS principals or EC2 instances allowed to log in to the tokens
+// they get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AWSRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSRoleSpec   `json:"spec"`
+	Status AWSRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSRoleList contains a list of AWSRole
+type AWSRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSRole `json:"items"`
+}
+
+// AWSRole type metadata.
+var (
+	AWSRoleKind             = reflect.TypeOf(AWSRole{}).Name()
+	AWSRoleGroupKind        = schema.GroupKind{Group: Group, Kind: AWSRoleKind}.String()
+	AWSRoleKindAPIVersion   = AWSRoleKind + "." + SchemeGroupVersion.String()
+	AWSRoleGroupVersionKind = SchemeGroupVersion.WithKind(AWSRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AWSRole{}, &AWSRoleList{})
+}
+
+// Validate the role as some fields are only allowed for one of the auth types
+func (r *AWSRole) Validate() error {
+	authType := authTypeIAM
+	if r.Spec.ForProvider.AuthType != nil {
+		authType = *r.Spec.ForProvider.AuthType
+	}
+
+	switch authType {
+	case authTypeIAM:
+		if !r.validEC2Only() {
+			return errors.New(errEC2Only)
+		}
+		if !r.validInferredRegion() {
+			return errors.New(errInferredRegion)
+		}
+		if r.hasEC2Bounds() && !r.inferredEC2() {
+			return errors.New(errEC2BoundsNotInferred)
+		}
+		if len(r.Spec.ForProvider.BoundIAMPrincipalARN) == 0 && !r.hasEC2Bounds() {
+			return errors.New(errIAMMinRequirements)
+		}
+	case authTypeEC2:
+		if !r.validIAMOnly() {
+			return errors.New(errIAMOnly)
+		}
+		if !r.hasEC2Bounds() {
+			return errors.New(errEC2MinRequirements)
+		}
+	default:
+		return errors.New(errUnknownAuthType)
+	}
+
+	return nil
+}
+
+func (r *AWSRole) hasEC2Bounds() bool {
+	p := r.Spec.ForProvider
+	return len(p.BoundAMIID) > 0 || len(p.BoundAccountID) > 0 || len(p.BoundRegion) > 0 ||
+		len(p.BoundVPCID) > 0 || len(p.BoundSubnetID) > 0 || len(p.BoundIAMRoleARN) > 0 ||
+		len(p.BoundIAMInstanceProfileARN) > 0 || len(p.BoundEC2InstanceID) > 0
+}
+
+func (r *AWSRole) inferredEC2() bool {
+	t := r.Spec.ForProvider.InferredEntityType
+	return t != nil && *t == "ec2_instance"
+}
+
+func (r *AWSRole) validInferredRegion() bool {
+	p := r.Spec.ForProvider
+	hasType := p.InferredEntityType != nil && *p.InferredEntityType != ""
+	hasRegion := p.InferredAWSRegion != nil && *p.InferredAWSRegion != ""
+	return hasType == hasRegion
+}
+
+// validIAMOnly checks that an ec2 role sets none of the iam parameters. As
+// vault returns false for resolve_aws_unique_ids on ec2 roles, only true is
+// rejected.
+func (r *AWSRole) validIAMOnly() bool {
+	p := r.Spec.ForProvider
+	return len(p.BoundIAMPrincipalARN) == 0 &&
+		(p.InferredEntityType == nil || *p.InferredEntityType == "") &&
+		(p.InferredAWSRegion == nil || *p.InferredAWSRegion == "") &&
+		(p.ResolveAWSUniqueIDs == nil || !*p.ResolveAWSUniqueIDs)
+}
+
+// validEC2Only checks that an iam role sets none of the ec2 parameters.
+func (r *AWSRole) validEC2Only() bool {
+	p := r.Spec.ForProvider
+	return (p.RoleTag == nil || *p.RoleTag == "") &&
+		(p.AllowInstanceMigration == nil || !*p.AllowInstanceMigration) &&
+		(p.DisallowReauthentication == nil || !*p.DisallowReauthentication)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AWSSTSRoleParameters are the configurable fields of an AWSSTSRole.
+type AWSSTSRoleParameters struct {
+	// The path the AWS auth backend is mounted at, with no leading or trailing /s. Defaults to aws.
+	// +optional
+	// +kubebuilder:default:=aws
+	Backend *string `json:"backend,omitempty"`
+
+	// The ARN of the role vault assumes to call AWS on behalf of logins from the account.
+	// +required
+	STSRole string `json:"stsRole"`
+
+	// The external ID expected by the trust policy of the STS role.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+}
+
+// AWSSTSRoleObservation are the observable fields of an AWSSTSRole.
+type AWSSTSRoleObservation struct {
+}
+
+// An AWSSTSRoleSpec defines the desired state of an AWSSTSRole.
+type AWSSTSRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AWSSTSRoleParameters `json:"forProvider"`
+}
+
+// An AWSSTSRoleStatus represents the observed state of an AWSSTSRole.
+type AWSSTSRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AWSSTSRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AWSSTSRole is the STS role an AWS auth backend assumes to verify logins
+// from the AWS account named after the external name, written at
+// auth/<backend>/config/sts/<account>.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AWSSTSRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSTSRoleSpec   `json:"spec"`
+	Status AWSSTSRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AWSSTSRoleList contains a list of AWSSTSRole
+type AWSSTSRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSSTSRole `json:"items"`
+}
+
+// AWSSTSRole type metadata.
+var (
+	AWSSTSRoleKind             = reflect.TypeOf(AWSSTSRole{}).Name()
+	AWSSTSRoleGroupKind        = schema.GroupKind{Group: Group, Kind: AWSSTSRoleKind}.String()
+	AWSSTSRoleKindAPIVersion   = AWSSTSRoleKind + "." + SchemeGroupVersion.String()
+	AWSSTSRoleGroupVersionKind = SchemeGroupVersion.WithKind(AWSSTSRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AWSSTSRole{}, &AWSSTSRoleList{})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AzureBackendConfigParameters are the configurable fields of an AzureBackendConfig.
+type AzureBackendConfigParameters struct {
+	// The path the Azure auth backend is mounted at, with no leading or trailing /s. Defaults to azure.
+	// +optional
+	// +kubebuilder:default:=azure
+	Backend *string `json:"backend,omitempty"`
+
+	// The tenant ID of the Azure Active Directory organization.
+	// +required
+	TenantID string `json:"tenantID"`
+
+	// The configured URL for the application registered in Azure Active Directory.
+	// +required
+	Resource string `json:"resource"`
+
+	// The Azure cloud environment. Defaults to AzurePublicCloud.
+	// +optional
+	// +kubebuilder:validation:Enum:=AzurePublicCloud;AzureUSGovernmentCloud;AzureChinaCloud;AzureGermanCloud
+	Environment *string `json:"environment,omitempty"`
+
+	// The client ID of the application vault uses to call Azure. When unset, vault falls back to
+	// the managed identity of its environment.
+	// +optional
+	ClientID *string `json:"clientID,omitempty"`
+
+	// A reference to the key of a Secret holding the client secret of the application.
+	// Vault does not return the client secret, so changes to it are detected through its hash, recorded in the status.
+	// +optional
+	ClientSecretSecretRef *xpv1.SecretKeySelector `json:"clientSecretSecretRef,omitempty"`
+}
+
+// AzureBackendConfigObservation are the observable fields of an AzureBackendConfig.
+type AzureBackendConfigObservation struct {
+	// A keyed hash of the client secret last written to vault, used to write the configuration again once
+	// the client secret of the application is rotated in the referenced Secret.
+	ClientSecretHash string `json:"clientSecretHash,omitempty"`
+}
+
+// An AzureBackendConfigSpec defines the desired state of an AzureBackendConfig.
+type AzureBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AzureBackendConfigParameters `json:"forProvider"`
+}
+
+// An AzureBackendConfigStatus represents the observed state of an AzureBackendConfig.
+type AzureBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AzureBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AzureBackendConfig is the configuration of an Azure auth backend, read
+// and written at auth/<backend>/config.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AzureBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureBackendConfigSpec   `json:"spec"`
+	Status AzureBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureBackendConfigList contains a list of AzureBackendConfig
+type AzureBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureBackendConfig `json:"items"`
+}
+
+// AzureBackendConfig type metadata.
+var (
+	AzureBackendConfigKind             = reflect.TypeOf(AzureBackendConfig{}).Name()
+	AzureBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: AzureBackendConfigKind}.String()
+	AzureBackendConfigKindAPIVersion   = AzureBackendConfigKind + "." + SchemeGroupVersion.String()
+	AzureBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(AzureBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AzureBackendConfig{}, &AzureBackendConfigList{})
+}
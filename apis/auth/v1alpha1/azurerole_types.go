@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Validation Errors
+	errAzureMinRequirements = "at least one of: `bound_service_principal_ids`, `bound_group_ids`, `bound_locations`, `bound_subscription_ids`, `bound_resource_groups` or `bound_scale_sets` must be set"
+)
+
+// AzureRoleParameters are the configurable fields of an AzureRole.
+type AzureRoleParameters struct {
+	// The path the Azure auth backend is mounted at, with no leading or trailing /s. Defaults to azure.
+	// +optional
+	// +kubebuilder:default:=azure
+	Backend *string `json:"backend,omitempty"`
+
+	// The service principal IDs allowed to log in.
+	// +optional
+	BoundServicePrincipalIDs []string `json:"boundServicePrincipalIDs,omitempty"`
+
+	// The group IDs the identity logging in must belong to.
+	// +optional
+	BoundGroupIDs []string `json:"boundGroupIDs,omitempty"`
+
+	// The locations the resources logging in must be in.
+	// +optional
+	BoundLocations []string `json:"boundLocations,omitempty"`
+
+	// The subscription IDs the resources logging in must belong to.
+	// +optional
+	BoundSubscriptionIDs []string `json:"boundSubscriptionIDs,omitempty"`
+
+	// The resource groups the resources logging in must belong to.
+	// +optional
+	BoundResourceGroups []string `json:"boundResourceGroups,omitempty"`
+
+	// The scale sets the virtual machines logging in must belong to.
+	// +optional
+	BoundScaleSets []string `json:"boundScaleSets,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// AzureRoleObservation are the observable fields of an AzureRole.
+type AzureRoleObservation struct {
+}
+
+// An AzureRoleSpec defines the desired state of an AzureRole.
+type AzureRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AzureRoleParameters `json:"forProvider"`
+}
+
+// An AzureRoleStatus represents the observed state of an AzureRole.
+type AzureRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AzureRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AzureRole is a role of an Azure auth backend, named after the external
+// name, binding the Azure identities allowed to log in to the tokens they get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type AzureRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureRoleSpec   `json:"spec"`
+	Status AzureRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AzureRoleList contains a list of AzureRole
+type AzureRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureRole `json:"items"`
+}
+
+// AzureRole type metadata.
+var (
+	AzureRoleKind             = reflect.TypeOf(AzureRole{}).Name()
+	AzureRoleGroupKind        = schema.GroupKind{Group: Group, Kind: AzureRoleKind}.String()
+	AzureRoleKindAPIVersion   = AzureRoleKind + "." + SchemeGroupVersion.String()
+	AzureRoleGroupVersionKind = SchemeGroupVersion.WithKind(AzureRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AzureRole{}, &AzureRoleList{})
+}
+
+// Validate the role as vault requires it to be bound to some identities
+func (r *AzureRole) Validate() error {
+	p := r.Spec.ForProvider
+	if len(p.BoundServicePrincipalIDs) == 0 && len(p.BoundGroupIDs) == 0 && len(p.BoundLocations) == 0 &&
+		len(p.BoundSubscriptionIDs) == 0 && len(p.BoundResourceGroups) == 0 && len(p.BoundScaleSets) == 0 {
+		return errors.New(errAzureMinRequirements)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CertRoleParameters are the configurable fields of a CertRole.
+type CertRoleParameters struct {
+	// The path the TLS certificate auth backend is mounted at, with no leading or trailing /s. Defaults to cert.
+	// +optional
+	// +kubebuilder:default:=cert
+	Backend *string `json:"backend,omitempty"`
+
+	// A reference to the key of a Secret holding the PEM encoded CA certificate used to verify client certificates.
+	// Exactly one of certificateSecretRef and certificateConfigMapRef must be set.
+	// +optional
+	CertificateSecretRef *xpv1.SecretKeySelector `json:"certificateSecretRef,omitempty"`
+
+	// A reference to the key of a ConfigMap holding the PEM encoded CA certificate used to verify client certificates.
+	// Exactly one of certificateSecretRef and certificateConfigMapRef must be set.
+	// +optional
+	CertificateConfigMapRef *ConfigMapKeySelector `json:"certificateConfigMapRef,omitempty"`
+
+	// The name to display on tokens issued against this role.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Constrain the Common Names in the client certificate with a globbed pattern.
+	// +optional
+	AllowedCommonNames []string `json:"allowedCommonNames,omitempty"`
+
+	// Constrain the Alternative Names in the client certificate with a globbed pattern.
+	// +optional
+	AllowedDNSSANs []string `json:"allowedDNSSANs,omitempty"`
+
+	// Constrain the Alternative Names in the client certificate with a globbed pattern.
+	// +optional
+	AllowedEmailSANs []string `json:"allowedEmailSANs,omitempty"`
+
+	// Constrain the Alternative Names in the client certificate with a globbed pattern.
+	// +optional
+	AllowedURISANs []string `json:"allowedURISANs,omitempty"`
+
+	// Constrain the Organizational Units in the client certificate with a globbed pattern.
+	// +optional
+	AllowedOrganizationalUnits []string `json:"allowedOrganizationalUnits,omitempty"`
+
+	// Require specific custom extensions to match the given oid:value pairs, with globbed values.
+	// +optional
+	RequiredExtensions []string `json:"requiredExtensions,omitempty"`
+
+	// Whether to check the revocation status of client certificates with OCSP.
+	// +optional
+	OCSPEnabled *bool `json:"ocspEnabled,omitempty"`
+
+	// PEM encoded CA certificates to use to verify OCSP responses, in addition to the certificate of the role.
+	// +optional
+	OCSPCACertificates *string `json:"ocspCACertificates,omitempty"`
+
+	// OCSP server URLs to use instead of the ones in the client certificates.
+	// +optional
+	OCSPServersOverride []string `json:"ocspServersOverride,omitempty"`
+
+	// Whether to allow logins when no OCSP server can be reached.
+	// +optional
+	OCSPFailOpen *bool `json:"ocspFailOpen,omitempty"`
+
+	// Whether to query all OCSP servers instead of stopping at the first answer.
+	// +optional
+	OCSPQueryAllServers *bool `json:"ocspQueryAllServers,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// CertRoleObservation are the observable fields of a CertRole.
+type CertRoleObservation struct {
+	// The SHA-256 fingerprints of the certificates vault holds for the role.
+	CertificateFingerprints []string `json:"certificateFingerprints,omitempty"`
+}
+
+// A CertRoleSpec defines the desired state of a CertRole.
+type CertRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertRoleParameters `json:"forProvider"`
+}
+
+// A CertRoleStatus represents the observed state of a CertRole.
+type CertRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CertRole is a role of a TLS certificate auth backend, read and written at
+// auth/<backend>/certs/<external name>, trusting the certificate it holds to
+// log clients in. The certificate is taken from a Secret or a ConfigMap and
+// compared with the one vault holds by its fingerprint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type CertRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertRoleSpec   `json:"spec"`
+	Status CertRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertRoleList contains a list of CertRole
+type CertRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertRole `json:"items"`
+}
+
+// CertRole type metadata.
+var (
+	CertRoleKind             = reflect.TypeOf(CertRole{}).Name()
+	CertRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CertRoleKind}.String()
+	CertRoleKindAPIVersion   = CertRoleKind + "." + SchemeGroupVersion.String()
+	CertRoleGroupVersionKind = SchemeGroupVersion.WithKind(CertRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CertRole{}, &CertRoleList{})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GCPBackendConfigParameters are the configurable fields of a GCPBackendConfig.
+type GCPBackendConfigParameters struct {
+	// The path the GCP auth backend is mounted at, with no leading or trailing /s. Defaults to gcp.
+	// +optional
+	// +kubebuilder:default:=gcp
+	Backend *string `json:"backend,omitempty"`
+
+	// A reference to the key of a Secret holding the JSON credentials of the service account vault
+	// uses to verify logins. When unset, vault falls back to the application default credentials.
+	// Vault does not return the credentials, so changes to them are detected through their hash, recorded in the status.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+
+	// The name of the attribute used as the alias of entities logging in with the iam type.
+	// +optional
+	// +kubebuilder:validation:Enum:=role_id;unique_id
+	IAMAlias *string `json:"iamAlias,omitempty"`
+
+	// The name of the attribute used as the alias of entities logging in with the gce type.
+	// +optional
+	// +kubebuilder:validation:Enum:=role_id;instance_id
+	GCEAlias *string `json:"gceAlias,omitempty"`
+}
+
+// GCPBackendConfigObservation are the observable fields of a GCPBackendConfig.
+type GCPBackendConfigObservation struct {
+	// A keyed hash of the service account credentials last written to vault, which vault does not return.
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+}
+
+// A GCPBackendConfigSpec defines the desired state of a GCPBackendConfig.
+type GCPBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GCPBackendConfigParameters `json:"forProvider"`
+}
+
+// A GCPBackendConfigStatus represents the observed state of a GCPBackendConfig.
+type GCPBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GCPBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GCPBackendConfig is the configuration of a GCP auth backend, read and
+// written at auth/<backend>/config. Vault cannot remove the configuration of
+// a backend, so deleting a GCPBackendConfig leaves it in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type GCPBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPBackendConfigSpec   `json:"spec"`
+	Status GCPBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPBackendConfigList contains a list of GCPBackendConfig
+type GCPBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPBackendConfig `json:"items"`
+}
+
+// GCPBackendConfig type metadata.
+var (
+	GCPBackendConfigKind             = reflect.TypeOf(GCPBackendConfig{}).Name()
+	GCPBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: GCPBackendConfigKind}.String()
+	GCPBackendConfigKindAPIVersion   = GCPBackendConfigKind + "." + SchemeGroupVersion.String()
+	GCPBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(GCPBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GCPBackendConfig{}, &GCPBackendConfigList{})
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Validation Errors
+	errUnknownGCPRoleType   = "type must be one of iam or gce"
+	errGCPIAMOnly           = "max_jwt_exp and allow_gce_inference are only valid when type is iam"
+	errGCPMinRequirements   = "bound_service_accounts must be set when type is iam"
+	errGCEBoundsNotInferred = "gce bounds are only valid with the iam type when allow_gce_inference is true"
+
+	gcpRoleTypeIAM = "iam"
+	gcpRoleTypeGCE = "gce"
+)
+
+// GCPRoleParameters are the configurable fields of a GCPRole.
+type GCPRoleParameters struct {
+	// The path the GCP auth backend is mounted at, with no leading or trailing /s. Defaults to gcp.
+	// +optional
+	// +kubebuilder:default:=gcp
+	Backend *string `json:"backend,omitempty"`
+
+	// The type of the role, either iam or gce. It cannot be changed once the role is created.
+	// +required
+	// +kubebuilder:validation:Enum:=iam;gce
+	Type string `json:"type"`
+
+	// The service accounts allowed to log in, by email or unique ID. Required for the iam type.
+	// +optional
+	BoundServiceAccounts []string `json:"boundServiceAccounts,omitempty"`
+
+	// The projects the service accounts or instances must belong to.
+	// +optional
+	BoundProjects []string `json:"boundProjects,omitempty"`
+
+	// If true, any auth token generated under this role will have associated group aliases, namely
+	// project-$PROJECT_ID, folder-$FOLDER_ID, and organization-$ORG_ID.
+	// +optional
+	AddGroupAliases *bool `json:"addGroupAliases,omitempty"`
+
+	// The number of seconds past the time of authentication that the login JWT must expire within.
+	// Only valid for the iam type.
+	// +optional
+	MaxJWTExp *int `json:"maxJWTExp,omitempty"`
+
+	// If true, GCE instances logging in with the iam type are matched against the gce bounds
+	// as well. Only valid for the iam type.
+	// +optional
+	AllowGCEInference *bool `json:"allowGCEInference,omitempty"`
+
+	// The zones GCE instances must run in.
+	// +optional
+	BoundZones []string `json:"boundZones,omitempty"`
+
+	// The regions GCE instances must run in.
+	// +optional
+	BoundRegions []string `json:"boundRegions,omitempty"`
+
+	// The instance groups GCE instances must belong to.
+	// +optional
+	BoundInstanceGroups []string `json:"boundInstanceGroups,omitempty"`
+
+	// The labels GCE instances must have.
+	// +optional
+	BoundLabels map[string]string `json:"boundLabels,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// GCPRoleObservation are the observable fields of a GCPRole.
+type GCPRoleObservation struct {
+}
+
+// A GCPRoleSpec defines the desired state of a GCPRole.
+type GCPRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GCPRoleParameters `json:"forProvider"`
+}
+
+// A GCPRoleStatus represents the observed state of a GCPRole.
+type GCPRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GCPRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GCPRole is a role of a GCP auth backend, named after the external name,
+// binding the service accounts or GCE instances allowed to log in to the
+// tokens they get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type GCPRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GCPRoleSpec   `json:"spec"`
+	Status GCPRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPRoleList contains a list of GCPRole
+type GCPRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPRole `json:"items"`
+}
+
+// GCPRole type metadata.
+var (
+	GCPRoleKind             = reflect.TypeOf(GCPRole{}).Name()
+	GCPRoleGroupKind        = schema.GroupKind{Group: Group, Kind: GCPRoleKind}.String()
+	GCPRoleKindAPIVersion   = GCPRoleKind + "." + SchemeGroupVersion.String()
+	GCPRoleGroupVersionKind = SchemeGroupVersion.WithKind(GCPRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GCPRole{}, &GCPRoleList{})
+}
+
+// Validate the role as some fields are only allowed for one of the role types
+func (r *GCPRole) Validate() error {
+	switch r.Spec.ForProvider.Type {
+	case gcpRoleTypeIAM:
+		if len(r.Spec.ForProvider.BoundServiceAccounts) == 0 {
+			return errors.New(errGCPMinRequirements)
+		}
+		if r.hasGCEBounds() && !r.allowGCEInference() {
+			return errors.New(errGCEBoundsNotInferred)
+		}
+	case gcpRoleTypeGCE:
+		if !r.validIAMOnly() {
+			return errors.New(errGCPIAMOnly)
+		}
+	default:
+		return errors.New(errUnknownGCPRoleType)
+	}
+
+	return nil
+}
+
+func (r *GCPRole) hasGCEBounds() bool {
+	p := r.Spec.ForProvider
+	return len(p.BoundZones) > 0 || len(p.BoundRegions) > 0 || len(p.BoundInstanceGroups) > 0 || len(p.BoundLabels) > 0
+}
+
+func (r *GCPRole) allowGCEInference() bool {
+	return r.Spec.ForProvider.AllowGCEInference != nil && *r.Spec.ForProvider.AllowGCEInference
+}
+
+func (r *GCPRole) validIAMOnly() bool {
+	p := r.Spec.ForProvider
+	return (p.MaxJWTExp == nil || *p.MaxJWTExp == 0) && !r.allowGCEInference()
+}
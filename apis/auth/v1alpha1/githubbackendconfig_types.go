@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GitHubBackendConfigParameters are the configurable fields of a GitHubBackendConfig.
+type GitHubBackendConfigParameters struct {
+	// The path the GitHub auth backend is mounted at, with no leading or trailing /s. Defaults to github.
+	// +optional
+	// +kubebuilder:default:=github
+	Backend *string `json:"backend,omitempty"`
+
+	// The GitHub organization users must be part of.
+	// +required
+	Organization string `json:"organization"`
+
+	// The ID of the organization. Vault looks it up from the organization name when unset, and the ID
+	// keeps logins working after the organization is renamed.
+	// +optional
+	OrganizationID *int `json:"organizationID,omitempty"`
+
+	// The API endpoint to use, for GitHub Enterprise servers. Defaults to the public GitHub API.
+	// +optional
+	BaseURL *string `json:"baseURL,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// GitHubBackendConfigObservation are the observable fields of a GitHubBackendConfig.
+type GitHubBackendConfigObservation struct {
+}
+
+// A GitHubBackendConfigSpec defines the desired state of a GitHubBackendConfig.
+type GitHubBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GitHubBackendConfigParameters `json:"forProvider"`
+}
+
+// A GitHubBackendConfigStatus represents the observed state of a GitHubBackendConfig.
+type GitHubBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GitHubBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GitHubBackendConfig is the configuration of a GitHub auth backend, read and
+// written at auth/<backend>/config. Vault cannot remove the configuration of
+// a backend, so deleting a GitHubBackendConfig leaves it in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type GitHubBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitHubBackendConfigSpec   `json:"spec"`
+	Status GitHubBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitHubBackendConfigList contains a list of GitHubBackendConfig
+type GitHubBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitHubBackendConfig `json:"items"`
+}
+
+// GitHubBackendConfig type metadata.
+var (
+	GitHubBackendConfigKind             = reflect.TypeOf(GitHubBackendConfig{}).Name()
+	GitHubBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: GitHubBackendConfigKind}.String()
+	GitHubBackendConfigKindAPIVersion   = GitHubBackendConfigKind + "." + SchemeGroupVersion.String()
+	GitHubBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(GitHubBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GitHubBackendConfig{}, &GitHubBackendConfigList{})
+}
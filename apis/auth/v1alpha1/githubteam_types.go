@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GitHubTeamParameters are the configurable fields of a GitHubTeam.
+type GitHubTeamParameters struct {
+	// The path the GitHub auth backend is mounted at, with no leading or trailing /s. Defaults to github.
+	// +optional
+	// +kubebuilder:default:=github
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the team.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// GitHubTeamObservation are the observable fields of a GitHubTeam.
+type GitHubTeamObservation struct {
+}
+
+// A GitHubTeamSpec defines the desired state of a GitHubTeam.
+type GitHubTeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GitHubTeamParameters `json:"forProvider"`
+}
+
+// A GitHubTeamStatus represents the observed state of a GitHubTeam.
+type GitHubTeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GitHubTeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GitHubTeam maps a team of the organization of a GitHub auth backend,
+// named after the external name, to the policies its members get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type GitHubTeam struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitHubTeamSpec   `json:"spec"`
+	Status GitHubTeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitHubTeamList contains a list of GitHubTeam
+type GitHubTeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitHubTeam `json:"items"`
+}
+
+// GitHubTeam type metadata.
+var (
+	GitHubTeamKind             = reflect.TypeOf(GitHubTeam{}).Name()
+	GitHubTeamGroupKind        = schema.GroupKind{Group: Group, Kind: GitHubTeamKind}.String()
+	GitHubTeamKindAPIVersion   = GitHubTeamKind + "." + SchemeGroupVersion.String()
+	GitHubTeamGroupVersionKind = SchemeGroupVersion.WithKind(GitHubTeamKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GitHubTeam{}, &GitHubTeamList{})
+}
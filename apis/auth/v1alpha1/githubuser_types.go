@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GitHubUserParameters are the configurable fields of a GitHubUser.
+type GitHubUserParameters struct {
+	// The path the GitHub auth backend is mounted at, with no leading or trailing /s. Defaults to github.
+	// +optional
+	// +kubebuilder:default:=github
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the user.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// GitHubUserObservation are the observable fields of a GitHubUser.
+type GitHubUserObservation struct {
+}
+
+// A GitHubUserSpec defines the desired state of a GitHubUser.
+type GitHubUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GitHubUserParameters `json:"forProvider"`
+}
+
+// A GitHubUserStatus represents the observed state of a GitHubUser.
+type GitHubUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GitHubUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GitHubUser maps a GitHub user, named after the external name, to the
+// policies they get on top of the ones of their teams.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type GitHubUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitHubUserSpec   `json:"spec"`
+	Status GitHubUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitHubUserList contains a list of GitHubUser
+type GitHubUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitHubUser `json:"items"`
+}
+
+// GitHubUser type metadata.
+var (
+	GitHubUserKind             = reflect.TypeOf(GitHubUser{}).Name()
+	GitHubUserGroupKind        = schema.GroupKind{Group: Group, Kind: GitHubUserKind}.String()
+	GitHubUserKindAPIVersion   = GitHubUserKind + "." + SchemeGroupVersion.String()
+	GitHubUserGroupVersionKind = SchemeGroupVersion.WithKind(GitHubUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&GitHubUser{}, &GitHubUserList{})
+}
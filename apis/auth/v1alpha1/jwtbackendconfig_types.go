@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// JWTBackendConfigParameters are the configurable fields of a JWTBackendConfig.
+type JWTBackendConfigParameters struct {
+	// The path the JWT/OIDC auth backend is mounted at, with no leading or trailing /s. Defaults to jwt.
+	// +optional
+	// +kubebuilder:default:=jwt
+	Backend *string `json:"backend,omitempty"`
+
+	// The OIDC Discovery URL, without any .well-known component (base path).
+	// Cannot be used in combination with jwtValidationPubkeys or jwksURL.
+	// +optional
+	OIDCDiscoveryURL *string `json:"oidcDiscoveryURL,omitempty"`
+
+	// The CA certificate or chain of certificates, in PEM format, to use to validate connections to the OIDC Discovery URL.
+	// If not set, system certificates are used.
+	// +optional
+	OIDCDiscoveryCAPEM *string `json:"oidcDiscoveryCAPEM,omitempty"`
+
+	// The OAuth Client ID from the provider for OIDC roles.
+	// +optional
+	OIDCClientID *string `json:"oidcClientID,omitempty"`
+
+	// A reference to the key of a Secret holding the OAuth Client Secret from the provider for OIDC roles.
+	// Vault does not return the client secret, so changes to it are detected through its hash, recorded in the status.
+	// +optional
+	OIDCClientSecretSecretRef *xpv1.SecretKeySelector `json:"oidcClientSecretSecretRef,omitempty"`
+
+	// The response mode to be used in the OAuth2 request. Allowed values are query and form_post.
+	// +optional
+	// +kubebuilder:validation:Enum:=query;form_post
+	OIDCResponseMode *string `json:"oidcResponseMode,omitempty"`
+
+	// The response types to request. Allowed values are code and id_token.
+	// +optional
+	OIDCResponseTypes []string `json:"oidcResponseTypes,omitempty"`
+
+	// JWKS URL to use to authenticate signatures. Cannot be used with oidcDiscoveryURL or jwtValidationPubkeys.
+	// +optional
+	JWKSURL *string `json:"jwksURL,omitempty"`
+
+	// The CA certificate or chain of certificates, in PEM format, to use to validate connections to the JWKS URL.
+	// If not set, system certificates are used.
+	// +optional
+	JWKSCAPEM *string `json:"jwksCAPEM,omitempty"`
+
+	// A list of PEM-encoded public keys to use to authenticate signatures locally.
+	// Cannot be used with jwksURL or oidcDiscoveryURL.
+	// +optional
+	JWTValidationPubkeys []string `json:"jwtValidationPubkeys,omitempty"`
+
+	// A list of supported signing algorithms. Defaults to RS256 for OIDC roles.
+	// +optional
+	JWTSupportedAlgs []string `json:"jwtSupportedAlgs,omitempty"`
+
+	// The value against which to match the iss claim in a JWT.
+	// +optional
+	BoundIssuer *string `json:"boundIssuer,omitempty"`
+
+	// The default role to use if none is provided during login.
+	// +optional
+	DefaultRole *string `json:"defaultRole,omitempty"`
+
+	// Pass namespace in the OIDC state parameter instead of as a separate query parameter.
+	// +optional
+	NamespaceInState *bool `json:"namespaceInState,omitempty"`
+
+	// Configuration options for provider-specific handling, such as the azure or gsuite providers.
+	// Providers with specific handling include Azure and Google. Values keep their JSON type, as
+	// vault expects booleans and integers for options such as fetch_groups or groups_recurse_max_depth.
+	// +optional
+	ProviderConfig map[string]apiextensionsv1.JSON `json:"providerConfig,omitempty"`
+}
+
+// JWTBackendConfigObservation are the observable fields of a JWTBackendConfig.
+type JWTBackendConfigObservation struct {
+	// A keyed hash of the OIDC client secret last written to vault, which does not return it. It tells when
+	// the Secret referenced by oidcClientSecretSecretRef changes.
+	ClientSecretHash string `json:"clientSecretHash,omitempty"`
+}
+
+// A JWTBackendConfigSpec defines the desired state of a JWTBackendConfig.
+type JWTBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JWTBackendConfigParameters `json:"forProvider"`
+}
+
+// A JWTBackendConfigStatus represents the observed state of a JWTBackendConfig.
+type JWTBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JWTBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A JWTBackendConfig is the configuration of a JWT/OIDC auth backend, read
+// and written at auth/<backend>/config. Vault cannot remove the configuration
+// of a backend, so deleting a JWTBackendConfig leaves it in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type JWTBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JWTBackendConfigSpec   `json:"spec"`
+	Status JWTBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JWTBackendConfigList contains a list of JWTBackendConfig
+type JWTBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JWTBackendConfig `json:"items"`
+}
+
+// JWTBackendConfig type metadata.
+var (
+	JWTBackendConfigKind             = reflect.TypeOf(JWTBackendConfig{}).Name()
+	JWTBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: JWTBackendConfigKind}.String()
+	JWTBackendConfigKindAPIVersion   = JWTBackendConfigKind + "." + SchemeGroupVersion.String()
+	JWTBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(JWTBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&JWTBackendConfig{}, &JWTBackendConfigList{})
+}
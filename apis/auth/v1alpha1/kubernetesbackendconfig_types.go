@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KubernetesBackendConfigParameters are the configurable fields of a KubernetesBackendConfig.
+type KubernetesBackendConfigParameters struct {
+	// The path the Kubernetes auth backend is mounted at, with no leading or trailing /s. Defaults to kubernetes.
+	// +optional
+	// +kubebuilder:default:=kubernetes
+	Backend *string `json:"backend,omitempty"`
+
+	// Host must be a host string, a host:port pair, or a URL to the base of the Kubernetes API server.
+	// Required unless useLocalCluster is set.
+	// +optional
+	KubernetesHost string `json:"kubernetesHost,omitempty"`
+
+	// PEM encoded CA cert for use by the TLS client used to talk with the Kubernetes API.
+	// +optional
+	KubernetesCACert *string `json:"kubernetesCACert,omitempty"`
+
+	// Fill kubernetesHost and kubernetesCACert, when they are not set, from the cluster the provider runs in:
+	// the host of the API server it talks to and the CA of the kube-root-ca.crt ConfigMap of its namespace.
+	// They are read again on every poll, so that the backend follows CA rotations.
+	// The host is usually the in-cluster address of the API server, such as https://10.96.0.1:443, which a
+	// vault running outside the cluster cannot reach. Set kubernetesHost to the external address in that case.
+	// +optional
+	UseLocalCluster *bool `json:"useLocalCluster,omitempty"`
+
+	// A reference to the key of a Secret holding the service account JWT used to access the TokenReview API
+	// to validate other JWTs during login. If not set, the JWT submitted in the login payload will be used.
+	// Vault does not return the token reviewer JWT, so changes to it are detected through its hash, recorded in the status.
+	// +optional
+	TokenReviewerJWTSecretRef *xpv1.SecretKeySelector `json:"tokenReviewerJWTSecretRef,omitempty"`
+
+	// List of PEM-formatted public keys or certificates used to verify the signatures of Kubernetes service account JWTs.
+	// +optional
+	PEMKeys []string `json:"pemKeys,omitempty"`
+
+	// JWT issuer. If no issuer is specified, then kubernetes.io/serviceaccount will be used as the default issuer.
+	// +optional
+	Issuer *string `json:"issuer,omitempty"`
+
+	// Disable JWT issuer validation. Allows to skip ISS validation.
+	// +optional
+	DisableISSValidation *bool `json:"disableISSValidation,omitempty"`
+
+	// Disable defaulting to the local CA cert and service account JWT when running in a Kubernetes pod.
+	// +optional
+	DisableLocalCAJWT *bool `json:"disableLocalCAJWT,omitempty"`
+}
+
+// KubernetesBackendConfigObservation are the observable fields of a KubernetesBackendConfig.
+type KubernetesBackendConfigObservation struct {
+	// A keyed hash of the token reviewer JWT last written to vault, compared with the token of
+	// tokenReviewerJWTSecretRef to write it again once the token is rotated.
+	TokenReviewerJWTHash string `json:"tokenReviewerJWTHash,omitempty"`
+}
+
+// A KubernetesBackendConfigSpec defines the desired state of a KubernetesBackendConfig.
+type KubernetesBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KubernetesBackendConfigParameters `json:"forProvider"`
+}
+
+// A KubernetesBackendConfigStatus represents the observed state of a KubernetesBackendConfig.
+type KubernetesBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KubernetesBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KubernetesBackendConfig is the configuration of a Kubernetes auth backend,
+// read and written at auth/<backend>/config. Vault cannot remove the
+// configuration of a backend, so deleting a KubernetesBackendConfig leaves it
+// in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type KubernetesBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesBackendConfigSpec   `json:"spec"`
+	Status KubernetesBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesBackendConfigList contains a list of KubernetesBackendConfig
+type KubernetesBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesBackendConfig `json:"items"`
+}
+
+// KubernetesBackendConfig type metadata.
+var (
+	KubernetesBackendConfigKind             = reflect.TypeOf(KubernetesBackendConfig{}).Name()
+	KubernetesBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: KubernetesBackendConfigKind}.String()
+	KubernetesBackendConfigKindAPIVersion   = KubernetesBackendConfigKind + "." + SchemeGroupVersion.String()
+	KubernetesBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(KubernetesBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KubernetesBackendConfig{}, &KubernetesBackendConfigList{})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KubernetesRoleParameters are the configurable fields of a KubernetesRole.
+type KubernetesRoleParameters struct {
+	// The path the Kubernetes auth backend is mounted at, with no leading or trailing /s. Defaults to kubernetes.
+	// +optional
+	// +kubebuilder:default:=kubernetes
+	Backend *string `json:"backend,omitempty"`
+
+	// List of service account names able to access this role. If set to ["*"] all names are allowed.
+	// +required
+	// +kubebuilder:validation:MinItems:=1
+	BoundServiceAccountNames []string `json:"boundServiceAccountNames"`
+
+	// List of namespaces allowed to access this role. If set to ["*"] all namespaces are allowed.
+	// +required
+	// +kubebuilder:validation:MinItems:=1
+	BoundServiceAccountNamespaces []string `json:"boundServiceAccountNamespaces"`
+
+	// Audience claim to verify in the JWT.
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+
+	// Configures how identity aliases are generated. Valid choices are serviceaccount_uid and serviceaccount_name.
+	// +optional
+	// +kubebuilder:validation:Enum:=serviceaccount_uid;serviceaccount_name
+	AliasNameSource *string `json:"aliasNameSource,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// KubernetesRoleObservation are the observable fields of a KubernetesRole.
+type KubernetesRoleObservation struct {
+}
+
+// A KubernetesRoleSpec defines the desired state of a KubernetesRole.
+type KubernetesRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KubernetesRoleParameters `json:"forProvider"`
+}
+
+// A KubernetesRoleStatus represents the observed state of a KubernetesRole.
+type KubernetesRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KubernetesRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KubernetesRole is a role of a Kubernetes auth backend, binding service
+// accounts to the tokens they get when logging in.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type KubernetesRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubernetesRoleSpec   `json:"spec"`
+	Status KubernetesRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KubernetesRoleList contains a list of KubernetesRole
+type KubernetesRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubernetesRole `json:"items"`
+}
+
+// KubernetesRole type metadata.
+var (
+	KubernetesRoleKind             = reflect.TypeOf(KubernetesRole{}).Name()
+	KubernetesRoleGroupKind        = schema.GroupKind{Group: Group, Kind: KubernetesRoleKind}.String()
+	KubernetesRoleKindAPIVersion   = KubernetesRoleKind + "." + SchemeGroupVersion.String()
+	KubernetesRoleGroupVersionKind = SchemeGroupVersion.WithKind(KubernetesRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KubernetesRole{}, &KubernetesRoleList{})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LDAPBackendConfigParameters are the configurable fields of an LDAPBackendConfig.
+type LDAPBackendConfigParameters struct {
+	// The path the LDAP auth backend is mounted at, with no leading or trailing /s. Defaults to ldap.
+	// +optional
+	// +kubebuilder:default:=ldap
+	Backend *string `json:"backend,omitempty"`
+
+	// The LDAP server to connect to, such as ldaps://ldap.example.com. Multiple URLs can be specified
+	// with commas, such as ldaps://ldap1.example.com,ldaps://ldap2.example.com; they are tried in order.
+	// +required
+	URL string `json:"url"`
+
+	// Distinguished name of the object to bind when performing user and group search.
+	// +optional
+	BindDN *string `json:"bindDN,omitempty"`
+
+	// A reference to the key of a Secret holding the password to use along with bindDN when performing user search.
+	// Vault does not return the bind password, so changes to it are detected through its hash, recorded in the status.
+	// +optional
+	BindPassSecretRef *xpv1.SecretKeySelector `json:"bindPassSecretRef,omitempty"`
+
+	// Base DN under which to perform user search.
+	// +optional
+	UserDN *string `json:"userDN,omitempty"`
+
+	// Attribute on user attribute object matching the username passed when authenticating. Defaults to cn.
+	// +optional
+	UserAttr *string `json:"userAttr,omitempty"`
+
+	// An optional LDAP user search filter, as a go template. The template can access the UserAttr and Username variables.
+	// +optional
+	UserFilter *string `json:"userFilter,omitempty"`
+
+	// The userPrincipalDomain used to construct the UPN string for the authenticating user.
+	// +optional
+	UPNDomain *string `json:"upnDomain,omitempty"`
+
+	// Use anonymous bind to discover the bind DN of a user.
+	// +optional
+	DiscoverDN *bool `json:"discoverDN,omitempty"`
+
+	// Whether to deny unauthenticated LDAP bind requests with an empty password. Defaults to true.
+	// +optional
+	DenyNullBind *bool `json:"denyNullBind,omitempty"`
+
+	// LDAP search base to use for group membership search.
+	// +optional
+	GroupDN *string `json:"groupDN,omitempty"`
+
+	// Go template used when constructing the group membership query. The template can access the
+	// UserDN and Username variables.
+	// +optional
+	GroupFilter *string `json:"groupFilter,omitempty"`
+
+	// LDAP attribute to follow on objects returned by groupFilter in order to enumerate user group membership.
+	// Defaults to cn.
+	// +optional
+	GroupAttr *string `json:"groupAttr,omitempty"`
+
+	// CA certificate to use when verifying the LDAP server certificate, in PEM format.
+	// +optional
+	Certificate *string `json:"certificate,omitempty"`
+
+	// If true, skips LDAP server SSL certificate verification. Insecure, use with caution.
+	// +optional
+	InsecureTLS *bool `json:"insecureTLS,omitempty"`
+
+	// If true, issues a StartTLS command after establishing an unencrypted connection.
+	// +optional
+	StartTLS *bool `json:"startTLS,omitempty"`
+
+	// Minimum TLS version to use.
+	// +optional
+	// +kubebuilder:validation:Enum:=tls10;tls11;tls12;tls13
+	TLSMinVersion *string `json:"tlsMinVersion,omitempty"`
+
+	// If set, user and group names assigned to policies within the backend will be case sensitive.
+	// +optional
+	CaseSensitiveNames *bool `json:"caseSensitiveNames,omitempty"`
+
+	// If true, the username is used as the alias name instead of the user DN.
+	// +optional
+	UsernameAsAlias *bool `json:"usernameAsAlias,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// LDAPBackendConfigObservation are the observable fields of an LDAPBackendConfig.
+type LDAPBackendConfigObservation struct {
+	// A keyed hash of the bind password last written to vault, so that a password rotated in the referenced
+	// Secret is written again.
+	BindPassHash string `json:"bindPassHash,omitempty"`
+}
+
+// An LDAPBackendConfigSpec defines the desired state of an LDAPBackendConfig.
+type LDAPBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LDAPBackendConfigParameters `json:"forProvider"`
+}
+
+// An LDAPBackendConfigStatus represents the observed state of an LDAPBackendConfig.
+type LDAPBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LDAPBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An LDAPBackendConfig is the configuration of an LDAP auth backend, read and
+// written at auth/<backend>/config. Vault cannot remove the configuration of
+// a backend, so deleting an LDAPBackendConfig leaves it in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type LDAPBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPBackendConfigSpec   `json:"spec"`
+	Status LDAPBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPBackendConfigList contains a list of LDAPBackendConfig
+type LDAPBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPBackendConfig `json:"items"`
+}
+
+// LDAPBackendConfig type metadata.
+var (
+	LDAPBackendConfigKind             = reflect.TypeOf(LDAPBackendConfig{}).Name()
+	LDAPBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPBackendConfigKind}.String()
+	LDAPBackendConfigKindAPIVersion   = LDAPBackendConfigKind + "." + SchemeGroupVersion.String()
+	LDAPBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(LDAPBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LDAPBackendConfig{}, &LDAPBackendConfigList{})
+}
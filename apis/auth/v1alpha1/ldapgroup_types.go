@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LDAPGroupParameters are the configurable fields of an LDAPGroup.
+type LDAPGroupParameters struct {
+	// The path the LDAP auth backend is mounted at, with no leading or trailing /s. Defaults to ldap.
+	// +optional
+	// +kubebuilder:default:=ldap
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the group.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// LDAPGroupObservation are the observable fields of an LDAPGroup.
+type LDAPGroupObservation struct {
+}
+
+// An LDAPGroupSpec defines the desired state of an LDAPGroup.
+type LDAPGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LDAPGroupParameters `json:"forProvider"`
+}
+
+// An LDAPGroupStatus represents the observed state of an LDAPGroup.
+type LDAPGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LDAPGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An LDAPGroup maps a group of an LDAP auth backend, named after the external
+// name, to the policies its members get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type LDAPGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPGroupSpec   `json:"spec"`
+	Status LDAPGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPGroupList contains a list of LDAPGroup
+type LDAPGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPGroup `json:"items"`
+}
+
+// LDAPGroup type metadata.
+var (
+	LDAPGroupKind             = reflect.TypeOf(LDAPGroup{}).Name()
+	LDAPGroupGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPGroupKind}.String()
+	LDAPGroupKindAPIVersion   = LDAPGroupKind + "." + SchemeGroupVersion.String()
+	LDAPGroupGroupVersionKind = SchemeGroupVersion.WithKind(LDAPGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LDAPGroup{}, &LDAPGroupList{})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LDAPUserParameters are the configurable fields of an LDAPUser.
+type LDAPUserParameters struct {
+	// The path the LDAP auth backend is mounted at, with no leading or trailing /s. Defaults to ldap.
+	// +optional
+	// +kubebuilder:default:=ldap
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the user.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// List of LDAP groups the user is a member of, in addition to the ones from the LDAP server.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// LDAPUserObservation are the observable fields of an LDAPUser.
+type LDAPUserObservation struct {
+}
+
+// An LDAPUserSpec defines the desired state of an LDAPUser.
+type LDAPUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LDAPUserParameters `json:"forProvider"`
+}
+
+// An LDAPUserStatus represents the observed state of an LDAPUser.
+type LDAPUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LDAPUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An LDAPUser maps a user of an LDAP auth backend, named after the external
+// name, to policies and groups on top of the ones from the LDAP server.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type LDAPUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LDAPUserSpec   `json:"spec"`
+	Status LDAPUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LDAPUserList contains a list of LDAPUser
+type LDAPUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LDAPUser `json:"items"`
+}
+
+// LDAPUser type metadata.
+var (
+	LDAPUserKind             = reflect.TypeOf(LDAPUser{}).Name()
+	LDAPUserGroupKind        = schema.GroupKind{Group: Group, Kind: LDAPUserKind}.String()
+	LDAPUserKindAPIVersion   = LDAPUserKind + "." + SchemeGroupVersion.String()
+	LDAPUserGroupVersionKind = SchemeGroupVersion.WithKind(LDAPUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LDAPUser{}, &LDAPUserList{})
+}
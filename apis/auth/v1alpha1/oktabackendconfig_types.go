@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OktaBackendConfigParameters are the configurable fields of an OktaBackendConfig.
+type OktaBackendConfigParameters struct {
+	// The path the Okta auth backend is mounted at, with no leading or trailing /s. Defaults to okta.
+	// +optional
+	// +kubebuilder:default:=okta
+	Backend *string `json:"backend,omitempty"`
+
+	// The name of the Okta organization, such as dev-123456 for dev-123456.okta.com.
+	// +required
+	OrgName string `json:"orgName"`
+
+	// A reference to the key of a Secret holding the Okta API token vault uses to look up the groups
+	// of users and to verify them with MFA. When unset, vault only checks credentials and grants no
+	// Okta group policies. Vault does not return the token, so changes to it are detected through its
+	// hash, recorded in the status.
+	// +optional
+	APITokenSecretRef *xpv1.SecretKeySelector `json:"apiTokenSecretRef,omitempty"`
+
+	// The Okta domain of the organization. Defaults to okta.com, use oktapreview.com for preview
+	// organizations or the domain of a custom URL.
+	// +optional
+	BaseURL *string `json:"baseURL,omitempty"`
+
+	// Whether users enrolled in MFA can log in without it. Only applies when the Okta sign-on policy
+	// does not require MFA.
+	// +optional
+	BypassOktaMFA *bool `json:"bypassOktaMFA,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// OktaBackendConfigObservation are the observable fields of an OktaBackendConfig.
+type OktaBackendConfigObservation struct {
+	// A keyed hash of the API token last written to vault. A new token in the referenced Secret is written
+	// once it no longer matches.
+	APITokenHash string `json:"apiTokenHash,omitempty"`
+}
+
+// An OktaBackendConfigSpec defines the desired state of an OktaBackendConfig.
+type OktaBackendConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OktaBackendConfigParameters `json:"forProvider"`
+}
+
+// An OktaBackendConfigStatus represents the observed state of an OktaBackendConfig.
+type OktaBackendConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OktaBackendConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OktaBackendConfig is the configuration of an Okta auth backend, read and
+// written at auth/<backend>/config. Vault cannot remove the configuration of
+// a backend, so deleting an OktaBackendConfig leaves it in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND",type="string",JSONPath=".spec.forProvider.backend"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type OktaBackendConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OktaBackendConfigSpec   `json:"spec"`
+	Status OktaBackendConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OktaBackendConfigList contains a list of OktaBackendConfig
+type OktaBackendConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OktaBackendConfig `json:"items"`
+}
+
+// OktaBackendConfig type metadata.
+var (
+	OktaBackendConfigKind             = reflect.TypeOf(OktaBackendConfig{}).Name()
+	OktaBackendConfigGroupKind        = schema.GroupKind{Group: Group, Kind: OktaBackendConfigKind}.String()
+	OktaBackendConfigKindAPIVersion   = OktaBackendConfigKind + "." + SchemeGroupVersion.String()
+	OktaBackendConfigGroupVersionKind = SchemeGroupVersion.WithKind(OktaBackendConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OktaBackendConfig{}, &OktaBackendConfigList{})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OktaGroupParameters are the configurable fields of an OktaGroup.
+type OktaGroupParameters struct {
+	// The path the Okta auth backend is mounted at, with no leading or trailing /s. Defaults to okta.
+	// +optional
+	// +kubebuilder:default:=okta
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the group.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// OktaGroupObservation are the observable fields of an OktaGroup.
+type OktaGroupObservation struct {
+}
+
+// An OktaGroupSpec defines the desired state of an OktaGroup.
+type OktaGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OktaGroupParameters `json:"forProvider"`
+}
+
+// An OktaGroupStatus represents the observed state of an OktaGroup.
+type OktaGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OktaGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OktaGroup maps a group of an Okta auth backend, named after the external
+// name, to the policies its members get.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type OktaGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OktaGroupSpec   `json:"spec"`
+	Status OktaGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OktaGroupList contains a list of OktaGroup
+type OktaGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OktaGroup `json:"items"`
+}
+
+// OktaGroup type metadata.
+var (
+	OktaGroupKind             = reflect.TypeOf(OktaGroup{}).Name()
+	OktaGroupGroupKind        = schema.GroupKind{Group: Group, Kind: OktaGroupKind}.String()
+	OktaGroupKindAPIVersion   = OktaGroupKind + "." + SchemeGroupVersion.String()
+	OktaGroupGroupVersionKind = SchemeGroupVersion.WithKind(OktaGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OktaGroup{}, &OktaGroupList{})
+}
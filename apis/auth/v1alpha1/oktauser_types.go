@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OktaUserParameters are the configurable fields of an OktaUser.
+type OktaUserParameters struct {
+	// The path the Okta auth backend is mounted at, with no leading or trailing /s. Defaults to okta.
+	// +optional
+	// +kubebuilder:default:=okta
+	Backend *string `json:"backend,omitempty"`
+
+	// List of policies associated to the user.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// List of Okta groups the user is a member of, in addition to the ones from Okta.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// OktaUserObservation are the observable fields of an OktaUser.
+type OktaUserObservation struct {
+}
+
+// An OktaUserSpec defines the desired state of an OktaUser.
+type OktaUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OktaUserParameters `json:"forProvider"`
+}
+
+// An OktaUserStatus represents the observed state of an OktaUser.
+type OktaUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OktaUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OktaUser maps a user of an Okta auth backend, named after the external
+// name, to policies and groups on top of the ones from Okta.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type OktaUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OktaUserSpec   `json:"spec"`
+	Status OktaUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OktaUserList contains a list of OktaUser
+type OktaUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OktaUser `json:"items"`
+}
+
+// OktaUser type metadata.
+var (
+	OktaUserKind             = reflect.TypeOf(OktaUser{}).Name()
+	OktaUserGroupKind        = schema.GroupKind{Group: Group, Kind: OktaUserKind}.String()
+	OktaUserKindAPIVersion   = OktaUserKind + "." + SchemeGroupVersion.String()
+	OktaUserGroupVersionKind = SchemeGroupVersion.WithKind(OktaUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OktaUser{}, &OktaUserList{})
+}
@@ -122,61 +122,24 @@ type RoleParameters struct {
 	// +kubebuilder:default:=0
 	MaxAge *int `json:"maxAge,omitempty"`
 
-	// The incremental lifetime for generated tokens. This current value of this will be referenced at renewal time.
-	// +optional
-	// +kubebuilder:default:=0
-	TokenTTL *int `json:"tokenTTL,omitempty"`
-
-	// The maximum lifetime for generated tokens. This current value of this will be referenced at renewal time.
-	// +optional
-	// +kubebuilder:default:=0
-	TokenMaxTTL *int `json:"tokenMaxTTL,omitempty"`
-
-	// List of policies to encode onto generated tokens.
-	// Depending on the auth method, this list may be supplemented by user/group/other values.
-	// +optional
-	TokenPolicies []string `json:"tokenPolicies,omitempty"`
-
-	// List of CIDR blocks; if set, specifies blocks of IP addresses which can authenticate successfully,
-	// and ties the resulting token to these blocks as well.
-	// +optional
-	TokenBoundCIDRS []string `json:"tokenBoundCIDRs,omitempty"`
-
-	// If set, will encode an explicit max TTL onto the token. This is a hard cap even if token_ttl
-	// and token_max_ttl would otherwise allow a renewal.
-	// +optional
-	// +kubebuilder:default:=0
-	TokenExplicitMaxTTL *int `json:"tokenExplicitMaxTTL,omitempty"`
-
-	// If set, the default policy will not be set on generated tokens; otherwise it will be added to the policies set in token_policies.
-	// +optional
-	// +kubebuilder:default:=false
-	TokenNoDefaultPolicy *bool `json:"tokenNoDefaultPolicy,omitempty"`
-
-	// The maximum number of times a generated token may be used (within its lifetime); 0 means unlimited.
-	// If you require the token to have the ability to create child tokens, you will need to set this value to 0.
-	// +optional
-	// +kubebuilder:default:=0
-	TokenNumUses *int `json:"tokenNumUses,omitempty"`
-
-	// The period, if any, to set on the token.
-	// +optional
-	// +kubebuilder:default:=0
-	TokenPeriod *int `json:"tokenPeriod,omitempty"`
-
-	// The type of token that should be generated. Can be service, batch, or default to use the mount's tuned
-	// default (which unless changed will be service tokens). For token store roles, there are two additional
-	// possibilities: default-service and default-batch which specify the type to return unless the client requests
-	// a different type at generation time.
-	// +optional
-	// +kubebuilder:default:="default"
-	// +kubebuilder:validation:Enum:=service;batch;default
-	TokenType *string `json:"tokenType,omitempty"`
+	TokenParameters `json:",inline"`
 }
 
 // RoleObservation are the observable fields of a Role.
 type RoleObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+
+	// The allowed redirect URIs vault starts an OIDC login with for this role.
+	// Only reported for roles of type oidc.
+	AcceptedRedirectURIs []string `json:"acceptedRedirectURIs,omitempty"`
+
+	// The allowed redirect URIs vault refuses to start an OIDC login with for
+	// this role, as it does not allow them. Only reported for roles of type oidc.
+	RejectedRedirectURIs []string `json:"rejectedRedirectURIs,omitempty"`
+
+	// When the allowed redirect URIs were last verified. They are verified
+	// again hourly, and whenever they change or the role is written.
+	RedirectURIsVerifiedTime *metav1.Time `json:"redirectURIsVerifiedTime,omitempty"`
 }
 
 // A RoleSpec defines the desired state of a Role.
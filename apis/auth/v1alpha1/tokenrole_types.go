@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TokenRoleParameters are the configurable fields of a TokenRole.
+type TokenRoleParameters struct {
+	// List of policies that can be attached to tokens created against the role.
+	// If empty, tokens may only get the policies of the token creating them.
+	// +optional
+	AllowedPolicies []string `json:"allowedPolicies,omitempty"`
+
+	// List of policies that cannot be attached to tokens created against the role.
+	// +optional
+	DisallowedPolicies []string `json:"disallowedPolicies,omitempty"`
+
+	// List of glob patterns matching the policies that can be attached to tokens created against the role.
+	// +optional
+	AllowedPoliciesGlob []string `json:"allowedPoliciesGlob,omitempty"`
+
+	// List of glob patterns matching the policies that cannot be attached to tokens created against the role.
+	// +optional
+	DisallowedPoliciesGlob []string `json:"disallowedPoliciesGlob,omitempty"`
+
+	// If true, tokens created against the role will be orphan tokens, with no parent.
+	// +optional
+	Orphan *bool `json:"orphan,omitempty"`
+
+	// Whether tokens created against the role can be renewed. Defaults to true.
+	// +optional
+	Renewable *bool `json:"renewable,omitempty"`
+
+	// If set, tokens created against the role will have the given suffix as part of their path.
+	// Changing it later does not change the path of existing tokens.
+	// +optional
+	PathSuffix *string `json:"pathSuffix,omitempty"`
+
+	// List of entity aliases tokens created against the role can be assigned to.
+	// +optional
+	AllowedEntityAliases []string `json:"allowedEntityAliases,omitempty"`
+
+	TokenParameters `json:",inline"`
+}
+
+// TokenRoleObservation are the observable fields of a TokenRole.
+type TokenRoleObservation struct {
+}
+
+// A TokenRoleSpec defines the desired state of a TokenRole.
+type TokenRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TokenRoleParameters `json:"forProvider"`
+}
+
+// A TokenRoleStatus represents the observed state of a TokenRole.
+type TokenRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TokenRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TokenRole is a role of the token auth backend, read and written at
+// auth/token/roles/<external name>, constraining the tokens created against
+// it. Token roles take neither token TTLs nor token policies, the policies of
+// their tokens are limited through allowedPolicies instead.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type TokenRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TokenRoleSpec   `json:"spec"`
+	Status TokenRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TokenRoleList contains a list of TokenRole
+type TokenRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TokenRole `json:"items"`
+}
+
+// TokenRole type metadata.
+var (
+	TokenRoleKind             = reflect.TypeOf(TokenRole{}).Name()
+	TokenRoleGroupKind        = schema.GroupKind{Group: Group, Kind: TokenRoleKind}.String()
+	TokenRoleKindAPIVersion   = TokenRoleKind + "." + SchemeGroupVersion.String()
+	TokenRoleGroupVersionKind = SchemeGroupVersion.WithKind(TokenRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TokenRole{}, &TokenRoleList{})
+}
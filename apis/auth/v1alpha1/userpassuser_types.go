@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// UserpassUserParameters are the configurable fields of a UserpassUser.
+type UserpassUserParameters struct {
+	// The path the userpass auth backend is mounted at, with no leading or trailing /s. Defaults to userpass.
+	// +optional
+	// +kubebuilder:default:=userpass
+	Backend *string `json:"backend,omitempty"`
+
+	// A reference to the key of a Secret holding the password of the user.
+	// Vault does not return the password, so changes to it are detected through its hash, recorded in the status.
+	// +required
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	TokenParameters `json:",inline"`
+}
+
+// UserpassUserObservation are the observable fields of a UserpassUser.
+type UserpassUserObservation struct {
+	// A keyed hash of the password last written to vault. Vault only keeps a bcrypt hash of the password,
+	// so a new password in the referenced Secret is noticed against this one.
+	PasswordHash string `json:"passwordHash,omitempty"`
+}
+
+// A UserpassUserSpec defines the desired state of a UserpassUser.
+type UserpassUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserpassUserParameters `json:"forProvider"`
+}
+
+// A UserpassUserStatus represents the observed state of a UserpassUser.
+type UserpassUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserpassUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserpassUser is a user of a userpass auth backend, logging in with a
+// password kept in a Kubernetes Secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type UserpassUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserpassUserSpec   `json:"spec"`
+	Status UserpassUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserpassUserList contains a list of UserpassUser
+type UserpassUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserpassUser `json:"items"`
+}
+
+// UserpassUser type metadata.
+var (
+	UserpassUserKind             = reflect.TypeOf(UserpassUser{}).Name()
+	UserpassUserGroupKind        = schema.GroupKind{Group: Group, Kind: UserpassUserKind}.String()
+	UserpassUserKindAPIVersion   = UserpassUserKind + "." + SchemeGroupVersion.String()
+	UserpassUserGroupVersionKind = SchemeGroupVersion.WithKind(UserpassUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&UserpassUser{}, &UserpassUserList{})
+}
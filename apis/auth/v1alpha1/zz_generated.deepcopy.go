@@ -22,9 +22,3490 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfig) DeepCopyInto(out *AWSClientConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfig.
+func (in *AWSClientConfig) DeepCopy() *AWSClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClientConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfigList) DeepCopyInto(out *AWSClientConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClientConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfigList.
+func (in *AWSClientConfigList) DeepCopy() *AWSClientConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClientConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfigObservation) DeepCopyInto(out *AWSClientConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfigObservation.
+func (in *AWSClientConfigObservation) DeepCopy() *AWSClientConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfigParameters) DeepCopyInto(out *AWSClientConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessKeySecretRef != nil {
+		in, out := &in.AccessKeySecretRef, &out.AccessKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.SecretKeySecretRef != nil {
+		in, out := &in.SecretKeySecretRef, &out.SecretKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMEndpoint != nil {
+		in, out := &in.IAMEndpoint, &out.IAMEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.STSEndpoint != nil {
+		in, out := &in.STSEndpoint, &out.STSEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.STSRegion != nil {
+		in, out := &in.STSRegion, &out.STSRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMServerIDHeaderValue != nil {
+		in, out := &in.IAMServerIDHeaderValue, &out.IAMServerIDHeaderValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfigParameters.
+func (in *AWSClientConfigParameters) DeepCopy() *AWSClientConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfigSpec) DeepCopyInto(out *AWSClientConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfigSpec.
+func (in *AWSClientConfigSpec) DeepCopy() *AWSClientConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfigStatus) DeepCopyInto(out *AWSClientConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfigStatus.
+func (in *AWSClientConfigStatus) DeepCopy() *AWSClientConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRole) DeepCopyInto(out *AWSRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRole.
+func (in *AWSRole) DeepCopy() *AWSRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleList) DeepCopyInto(out *AWSRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleList.
+func (in *AWSRoleList) DeepCopy() *AWSRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleObservation) DeepCopyInto(out *AWSRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleObservation.
+func (in *AWSRoleObservation) DeepCopy() *AWSRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleParameters) DeepCopyInto(out *AWSRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthType != nil {
+		in, out := &in.AuthType, &out.AuthType
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoundIAMPrincipalARN != nil {
+		in, out := &in.BoundIAMPrincipalARN, &out.BoundIAMPrincipalARN
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InferredEntityType != nil {
+		in, out := &in.InferredEntityType, &out.InferredEntityType
+		*out = new(string)
+		**out = **in
+	}
+	if in.InferredAWSRegion != nil {
+		in, out := &in.InferredAWSRegion, &out.InferredAWSRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolveAWSUniqueIDs != nil {
+		in, out := &in.ResolveAWSUniqueIDs, &out.ResolveAWSUniqueIDs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BoundAMIID != nil {
+		in, out := &in.BoundAMIID, &out.BoundAMIID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundAccountID != nil {
+		in, out := &in.BoundAccountID, &out.BoundAccountID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundRegion != nil {
+		in, out := &in.BoundRegion, &out.BoundRegion
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundVPCID != nil {
+		in, out := &in.BoundVPCID, &out.BoundVPCID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundSubnetID != nil {
+		in, out := &in.BoundSubnetID, &out.BoundSubnetID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundIAMRoleARN != nil {
+		in, out := &in.BoundIAMRoleARN, &out.BoundIAMRoleARN
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundIAMInstanceProfileARN != nil {
+		in, out := &in.BoundIAMInstanceProfileARN, &out.BoundIAMInstanceProfileARN
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundEC2InstanceID != nil {
+		in, out := &in.BoundEC2InstanceID, &out.BoundEC2InstanceID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleTag != nil {
+		in, out := &in.RoleTag, &out.RoleTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowInstanceMigration != nil {
+		in, out := &in.AllowInstanceMigration, &out.AllowInstanceMigration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisallowReauthentication != nil {
+		in, out := &in.DisallowReauthentication, &out.DisallowReauthentication
+		*out = new(bool)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleParameters.
+func (in *AWSRoleParameters) DeepCopy() *AWSRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleSpec) DeepCopyInto(out *AWSRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleSpec.
+func (in *AWSRoleSpec) DeepCopy() *AWSRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleStatus) DeepCopyInto(out *AWSRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleStatus.
+func (in *AWSRoleStatus) DeepCopy() *AWSRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRole) DeepCopyInto(out *AWSSTSRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRole.
+func (in *AWSSTSRole) DeepCopy() *AWSSTSRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSTSRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRoleList) DeepCopyInto(out *AWSSTSRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSTSRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRoleList.
+func (in *AWSSTSRoleList) DeepCopy() *AWSSTSRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSTSRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRoleObservation) DeepCopyInto(out *AWSSTSRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRoleObservation.
+func (in *AWSSTSRoleObservation) DeepCopy() *AWSSTSRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRoleParameters) DeepCopyInto(out *AWSSTSRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRoleParameters.
+func (in *AWSSTSRoleParameters) DeepCopy() *AWSSTSRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRoleSpec) DeepCopyInto(out *AWSSTSRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRoleSpec.
+func (in *AWSSTSRoleSpec) DeepCopy() *AWSSTSRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSTSRoleStatus) DeepCopyInto(out *AWSSTSRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSTSRoleStatus.
+func (in *AWSSTSRoleStatus) DeepCopy() *AWSSTSRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSTSRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRole) DeepCopyInto(out *AppRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRole.
+func (in *AppRole) DeepCopy() *AppRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleList) DeepCopyInto(out *AppRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleList.
+func (in *AppRoleList) DeepCopy() *AppRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleObservation) DeepCopyInto(out *AppRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleObservation.
+func (in *AppRoleObservation) DeepCopy() *AppRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleParameters) DeepCopyInto(out *AppRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.BindSecretID != nil {
+		in, out := &in.BindSecretID, &out.BindSecretID
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretIDBoundCIDRs != nil {
+		in, out := &in.SecretIDBoundCIDRs, &out.SecretIDBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretIDNumUses != nil {
+		in, out := &in.SecretIDNumUses, &out.SecretIDNumUses
+		*out = new(int)
+		**out = **in
+	}
+	if in.SecretIDTTL != nil {
+		in, out := &in.SecretIDTTL, &out.SecretIDTTL
+		*out = new(int)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleParameters.
+func (in *AppRoleParameters) DeepCopy() *AppRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretID) DeepCopyInto(out *AppRoleSecretID) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretID.
+func (in *AppRoleSecretID) DeepCopy() *AppRoleSecretID {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleSecretID) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDList) DeepCopyInto(out *AppRoleSecretIDList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppRoleSecretID, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDList.
+func (in *AppRoleSecretIDList) DeepCopy() *AppRoleSecretIDList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleSecretIDList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDObservation) DeepCopyInto(out *AppRoleSecretIDObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDObservation.
+func (in *AppRoleSecretIDObservation) DeepCopy() *AppRoleSecretIDObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDParameters) DeepCopyInto(out *AppRoleSecretIDParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CIDRList != nil {
+		in, out := &in.CIDRList, &out.CIDRList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int)
+		**out = **in
+	}
+	if in.NumUses != nil {
+		in, out := &in.NumUses, &out.NumUses
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDParameters.
+func (in *AppRoleSecretIDParameters) DeepCopy() *AppRoleSecretIDParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDSpec) DeepCopyInto(out *AppRoleSecretIDSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDSpec.
+func (in *AppRoleSecretIDSpec) DeepCopy() *AppRoleSecretIDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDStatus) DeepCopyInto(out *AppRoleSecretIDStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDStatus.
+func (in *AppRoleSecretIDStatus) DeepCopy() *AppRoleSecretIDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSpec) DeepCopyInto(out *AppRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSpec.
+func (in *AppRoleSpec) DeepCopy() *AppRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleStatus) DeepCopyInto(out *AppRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleStatus.
+func (in *AppRoleStatus) DeepCopy() *AppRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfig) DeepCopyInto(out *AzureBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfig.
+func (in *AzureBackendConfig) DeepCopy() *AzureBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfigList) DeepCopyInto(out *AzureBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfigList.
+func (in *AzureBackendConfigList) DeepCopy() *AzureBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfigObservation) DeepCopyInto(out *AzureBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfigObservation.
+func (in *AzureBackendConfigObservation) DeepCopy() *AzureBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfigParameters) DeepCopyInto(out *AzureBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientSecretSecretRef != nil {
+		in, out := &in.ClientSecretSecretRef, &out.ClientSecretSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfigParameters.
+func (in *AzureBackendConfigParameters) DeepCopy() *AzureBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfigSpec) DeepCopyInto(out *AzureBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfigSpec.
+func (in *AzureBackendConfigSpec) DeepCopy() *AzureBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendConfigStatus) DeepCopyInto(out *AzureBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendConfigStatus.
+func (in *AzureBackendConfigStatus) DeepCopy() *AzureBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRole) DeepCopyInto(out *AzureRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRole.
+func (in *AzureRole) DeepCopy() *AzureRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRoleList) DeepCopyInto(out *AzureRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRoleList.
+func (in *AzureRoleList) DeepCopy() *AzureRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRoleObservation) DeepCopyInto(out *AzureRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRoleObservation.
+func (in *AzureRoleObservation) DeepCopy() *AzureRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRoleParameters) DeepCopyInto(out *AzureRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoundServicePrincipalIDs != nil {
+		in, out := &in.BoundServicePrincipalIDs, &out.BoundServicePrincipalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundGroupIDs != nil {
+		in, out := &in.BoundGroupIDs, &out.BoundGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundLocations != nil {
+		in, out := &in.BoundLocations, &out.BoundLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundSubscriptionIDs != nil {
+		in, out := &in.BoundSubscriptionIDs, &out.BoundSubscriptionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundResourceGroups != nil {
+		in, out := &in.BoundResourceGroups, &out.BoundResourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundScaleSets != nil {
+		in, out := &in.BoundScaleSets, &out.BoundScaleSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRoleParameters.
+func (in *AzureRoleParameters) DeepCopy() *AzureRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRoleSpec) DeepCopyInto(out *AzureRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRoleSpec.
+func (in *AzureRoleSpec) DeepCopy() *AzureRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRoleStatus) DeepCopyInto(out *AzureRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRoleStatus.
+func (in *AzureRoleStatus) DeepCopy() *AzureRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRole) DeepCopyInto(out *CertRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRole.
+func (in *CertRole) DeepCopy() *CertRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRoleList) DeepCopyInto(out *CertRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRoleList.
+func (in *CertRoleList) DeepCopy() *CertRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRoleObservation) DeepCopyInto(out *CertRoleObservation) {
+	*out = *in
+	if in.CertificateFingerprints != nil {
+		in, out := &in.CertificateFingerprints, &out.CertificateFingerprints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRoleObservation.
+func (in *CertRoleObservation) DeepCopy() *CertRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRoleParameters) DeepCopyInto(out *CertRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateSecretRef != nil {
+		in, out := &in.CertificateSecretRef, &out.CertificateSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CertificateConfigMapRef != nil {
+		in, out := &in.CertificateConfigMapRef, &out.CertificateConfigMapRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedCommonNames != nil {
+		in, out := &in.AllowedCommonNames, &out.AllowedCommonNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDNSSANs != nil {
+		in, out := &in.AllowedDNSSANs, &out.AllowedDNSSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedEmailSANs != nil {
+		in, out := &in.AllowedEmailSANs, &out.AllowedEmailSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedURISANs != nil {
+		in, out := &in.AllowedURISANs, &out.AllowedURISANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrganizationalUnits != nil {
+		in, out := &in.AllowedOrganizationalUnits, &out.AllowedOrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredExtensions != nil {
+		in, out := &in.RequiredExtensions, &out.RequiredExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OCSPEnabled != nil {
+		in, out := &in.OCSPEnabled, &out.OCSPEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCSPCACertificates != nil {
+		in, out := &in.OCSPCACertificates, &out.OCSPCACertificates
+		*out = new(string)
+		**out = **in
+	}
+	if in.OCSPServersOverride != nil {
+		in, out := &in.OCSPServersOverride, &out.OCSPServersOverride
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OCSPFailOpen != nil {
+		in, out := &in.OCSPFailOpen, &out.OCSPFailOpen
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCSPQueryAllServers != nil {
+		in, out := &in.OCSPQueryAllServers, &out.OCSPQueryAllServers
+		*out = new(bool)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRoleParameters.
+func (in *CertRoleParameters) DeepCopy() *CertRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRoleSpec) DeepCopyInto(out *CertRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRoleSpec.
+func (in *CertRoleSpec) DeepCopy() *CertRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertRoleStatus) DeepCopyInto(out *CertRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertRoleStatus.
+func (in *CertRoleStatus) DeepCopy() *CertRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfig) DeepCopyInto(out *GCPBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfig.
+func (in *GCPBackendConfig) DeepCopy() *GCPBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfigList) DeepCopyInto(out *GCPBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfigList.
+func (in *GCPBackendConfigList) DeepCopy() *GCPBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfigObservation) DeepCopyInto(out *GCPBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfigObservation.
+func (in *GCPBackendConfigObservation) DeepCopy() *GCPBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfigParameters) DeepCopyInto(out *GCPBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.IAMAlias != nil {
+		in, out := &in.IAMAlias, &out.IAMAlias
+		*out = new(string)
+		**out = **in
+	}
+	if in.GCEAlias != nil {
+		in, out := &in.GCEAlias, &out.GCEAlias
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfigParameters.
+func (in *GCPBackendConfigParameters) DeepCopy() *GCPBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfigSpec) DeepCopyInto(out *GCPBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfigSpec.
+func (in *GCPBackendConfigSpec) DeepCopy() *GCPBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPBackendConfigStatus) DeepCopyInto(out *GCPBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPBackendConfigStatus.
+func (in *GCPBackendConfigStatus) DeepCopy() *GCPBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRole) DeepCopyInto(out *GCPRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRole.
+func (in *GCPRole) DeepCopy() *GCPRole {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRoleList) DeepCopyInto(out *GCPRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GCPRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRoleList.
+func (in *GCPRoleList) DeepCopy() *GCPRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GCPRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRoleObservation) DeepCopyInto(out *GCPRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRoleObservation.
+func (in *GCPRoleObservation) DeepCopy() *GCPRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRoleParameters) DeepCopyInto(out *GCPRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoundServiceAccounts != nil {
+		in, out := &in.BoundServiceAccounts, &out.BoundServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundProjects != nil {
+		in, out := &in.BoundProjects, &out.BoundProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddGroupAliases != nil {
+		in, out := &in.AddGroupAliases, &out.AddGroupAliases
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxJWTExp != nil {
+		in, out := &in.MaxJWTExp, &out.MaxJWTExp
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllowGCEInference != nil {
+		in, out := &in.AllowGCEInference, &out.AllowGCEInference
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BoundZones != nil {
+		in, out := &in.BoundZones, &out.BoundZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundRegions != nil {
+		in, out := &in.BoundRegions, &out.BoundRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundInstanceGroups != nil {
+		in, out := &in.BoundInstanceGroups, &out.BoundInstanceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundLabels != nil {
+		in, out := &in.BoundLabels, &out.BoundLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRoleParameters.
+func (in *GCPRoleParameters) DeepCopy() *GCPRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRoleSpec) DeepCopyInto(out *GCPRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRoleSpec.
+func (in *GCPRoleSpec) DeepCopy() *GCPRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPRoleStatus) DeepCopyInto(out *GCPRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPRoleStatus.
+func (in *GCPRoleStatus) DeepCopy() *GCPRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfig) DeepCopyInto(out *GitHubBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfig.
+func (in *GitHubBackendConfig) DeepCopy() *GitHubBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfigList) DeepCopyInto(out *GitHubBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitHubBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfigList.
+func (in *GitHubBackendConfigList) DeepCopy() *GitHubBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfigObservation) DeepCopyInto(out *GitHubBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfigObservation.
+func (in *GitHubBackendConfigObservation) DeepCopy() *GitHubBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfigParameters) DeepCopyInto(out *GitHubBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationID != nil {
+		in, out := &in.OrganizationID, &out.OrganizationID
+		*out = new(int)
+		**out = **in
+	}
+	if in.BaseURL != nil {
+		in, out := &in.BaseURL, &out.BaseURL
+		*out = new(string)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfigParameters.
+func (in *GitHubBackendConfigParameters) DeepCopy() *GitHubBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfigSpec) DeepCopyInto(out *GitHubBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfigSpec.
+func (in *GitHubBackendConfigSpec) DeepCopy() *GitHubBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubBackendConfigStatus) DeepCopyInto(out *GitHubBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubBackendConfigStatus.
+func (in *GitHubBackendConfigStatus) DeepCopy() *GitHubBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeam) DeepCopyInto(out *GitHubTeam) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeam.
+func (in *GitHubTeam) DeepCopy() *GitHubTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubTeam) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeamList) DeepCopyInto(out *GitHubTeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitHubTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeamList.
+func (in *GitHubTeamList) DeepCopy() *GitHubTeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubTeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeamObservation) DeepCopyInto(out *GitHubTeamObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeamObservation.
+func (in *GitHubTeamObservation) DeepCopy() *GitHubTeamObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeamObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeamParameters) DeepCopyInto(out *GitHubTeamParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeamParameters.
+func (in *GitHubTeamParameters) DeepCopy() *GitHubTeamParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeamParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeamSpec) DeepCopyInto(out *GitHubTeamSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeamSpec.
+func (in *GitHubTeamSpec) DeepCopy() *GitHubTeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubTeamStatus) DeepCopyInto(out *GitHubTeamStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubTeamStatus.
+func (in *GitHubTeamStatus) DeepCopy() *GitHubTeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubTeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUser) DeepCopyInto(out *GitHubUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUser.
+func (in *GitHubUser) DeepCopy() *GitHubUser {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUserList) DeepCopyInto(out *GitHubUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitHubUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUserList.
+func (in *GitHubUserList) DeepCopy() *GitHubUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitHubUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUserObservation) DeepCopyInto(out *GitHubUserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUserObservation.
+func (in *GitHubUserObservation) DeepCopy() *GitHubUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUserParameters) DeepCopyInto(out *GitHubUserParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUserParameters.
+func (in *GitHubUserParameters) DeepCopy() *GitHubUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUserSpec) DeepCopyInto(out *GitHubUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUserSpec.
+func (in *GitHubUserSpec) DeepCopy() *GitHubUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubUserStatus) DeepCopyInto(out *GitHubUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubUserStatus.
+func (in *GitHubUserStatus) DeepCopy() *GitHubUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfig) DeepCopyInto(out *JWTBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfig.
+func (in *JWTBackendConfig) DeepCopy() *JWTBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfigList) DeepCopyInto(out *JWTBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JWTBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfigList.
+func (in *JWTBackendConfigList) DeepCopy() *JWTBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JWTBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfigObservation) DeepCopyInto(out *JWTBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfigObservation.
+func (in *JWTBackendConfigObservation) DeepCopy() *JWTBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfigParameters) DeepCopyInto(out *JWTBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCDiscoveryURL != nil {
+		in, out := &in.OIDCDiscoveryURL, &out.OIDCDiscoveryURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCDiscoveryCAPEM != nil {
+		in, out := &in.OIDCDiscoveryCAPEM, &out.OIDCDiscoveryCAPEM
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCClientID != nil {
+		in, out := &in.OIDCClientID, &out.OIDCClientID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCClientSecretSecretRef != nil {
+		in, out := &in.OIDCClientSecretSecretRef, &out.OIDCClientSecretSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.OIDCResponseMode != nil {
+		in, out := &in.OIDCResponseMode, &out.OIDCResponseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCResponseTypes != nil {
+		in, out := &in.OIDCResponseTypes, &out.OIDCResponseTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWKSURL != nil {
+		in, out := &in.JWKSURL, &out.JWKSURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWKSCAPEM != nil {
+		in, out := &in.JWKSCAPEM, &out.JWKSCAPEM
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWTValidationPubkeys != nil {
+		in, out := &in.JWTValidationPubkeys, &out.JWTValidationPubkeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWTSupportedAlgs != nil {
+		in, out := &in.JWTSupportedAlgs, &out.JWTSupportedAlgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundIssuer != nil {
+		in, out := &in.BoundIssuer, &out.BoundIssuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceInState != nil {
+		in, out := &in.NamespaceInState, &out.NamespaceInState
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfigParameters.
+func (in *JWTBackendConfigParameters) DeepCopy() *JWTBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfigSpec) DeepCopyInto(out *JWTBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfigSpec.
+func (in *JWTBackendConfigSpec) DeepCopy() *JWTBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTBackendConfigStatus) DeepCopyInto(out *JWTBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTBackendConfigStatus.
+func (in *JWTBackendConfigStatus) DeepCopy() *JWTBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfig) DeepCopyInto(out *KubernetesBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfig.
+func (in *KubernetesBackendConfig) DeepCopy() *KubernetesBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfigList) DeepCopyInto(out *KubernetesBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfigList.
+func (in *KubernetesBackendConfigList) DeepCopy() *KubernetesBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfigObservation) DeepCopyInto(out *KubernetesBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfigObservation.
+func (in *KubernetesBackendConfigObservation) DeepCopy() *KubernetesBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfigParameters) DeepCopyInto(out *KubernetesBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubernetesCACert != nil {
+		in, out := &in.KubernetesCACert, &out.KubernetesCACert
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseLocalCluster != nil {
+		in, out := &in.UseLocalCluster, &out.UseLocalCluster
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TokenReviewerJWTSecretRef != nil {
+		in, out := &in.TokenReviewerJWTSecretRef, &out.TokenReviewerJWTSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PEMKeys != nil {
+		in, out := &in.PEMKeys, &out.PEMKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisableISSValidation != nil {
+		in, out := &in.DisableISSValidation, &out.DisableISSValidation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableLocalCAJWT != nil {
+		in, out := &in.DisableLocalCAJWT, &out.DisableLocalCAJWT
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfigParameters.
+func (in *KubernetesBackendConfigParameters) DeepCopy() *KubernetesBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfigSpec) DeepCopyInto(out *KubernetesBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfigSpec.
+func (in *KubernetesBackendConfigSpec) DeepCopy() *KubernetesBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesBackendConfigStatus) DeepCopyInto(out *KubernetesBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesBackendConfigStatus.
+func (in *KubernetesBackendConfigStatus) DeepCopy() *KubernetesBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRole) DeepCopyInto(out *KubernetesRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRole.
+func (in *KubernetesRole) DeepCopy() *KubernetesRole {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRoleList) DeepCopyInto(out *KubernetesRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRoleList.
+func (in *KubernetesRoleList) DeepCopy() *KubernetesRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRoleObservation) DeepCopyInto(out *KubernetesRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRoleObservation.
+func (in *KubernetesRoleObservation) DeepCopy() *KubernetesRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRoleParameters) DeepCopyInto(out *KubernetesRoleParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.BoundServiceAccountNames != nil {
+		in, out := &in.BoundServiceAccountNames, &out.BoundServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundServiceAccountNamespaces != nil {
+		in, out := &in.BoundServiceAccountNamespaces, &out.BoundServiceAccountNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasNameSource != nil {
+		in, out := &in.AliasNameSource, &out.AliasNameSource
+		*out = new(string)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRoleParameters.
+func (in *KubernetesRoleParameters) DeepCopy() *KubernetesRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRoleSpec) DeepCopyInto(out *KubernetesRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRoleSpec.
+func (in *KubernetesRoleSpec) DeepCopy() *KubernetesRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesRoleStatus) DeepCopyInto(out *KubernetesRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesRoleStatus.
+func (in *KubernetesRoleStatus) DeepCopy() *KubernetesRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfig) DeepCopyInto(out *LDAPBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfig.
+func (in *LDAPBackendConfig) DeepCopy() *LDAPBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfigList) DeepCopyInto(out *LDAPBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfigList.
+func (in *LDAPBackendConfigList) DeepCopy() *LDAPBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfigObservation) DeepCopyInto(out *LDAPBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfigObservation.
+func (in *LDAPBackendConfigObservation) DeepCopy() *LDAPBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfigParameters) DeepCopyInto(out *LDAPBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.BindDN != nil {
+		in, out := &in.BindDN, &out.BindDN
+		*out = new(string)
+		**out = **in
+	}
+	if in.BindPassSecretRef != nil {
+		in, out := &in.BindPassSecretRef, &out.BindPassSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.UserDN != nil {
+		in, out := &in.UserDN, &out.UserDN
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserAttr != nil {
+		in, out := &in.UserAttr, &out.UserAttr
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserFilter != nil {
+		in, out := &in.UserFilter, &out.UserFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.UPNDomain != nil {
+		in, out := &in.UPNDomain, &out.UPNDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiscoverDN != nil {
+		in, out := &in.DiscoverDN, &out.DiscoverDN
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DenyNullBind != nil {
+		in, out := &in.DenyNullBind, &out.DenyNullBind
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GroupDN != nil {
+		in, out := &in.GroupDN, &out.GroupDN
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupFilter != nil {
+		in, out := &in.GroupFilter, &out.GroupFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupAttr != nil {
+		in, out := &in.GroupAttr, &out.GroupAttr
+		*out = new(string)
+		**out = **in
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.InsecureTLS != nil {
+		in, out := &in.InsecureTLS, &out.InsecureTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StartTLS != nil {
+		in, out := &in.StartTLS, &out.StartTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSMinVersion != nil {
+		in, out := &in.TLSMinVersion, &out.TLSMinVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaseSensitiveNames != nil {
+		in, out := &in.CaseSensitiveNames, &out.CaseSensitiveNames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UsernameAsAlias != nil {
+		in, out := &in.UsernameAsAlias, &out.UsernameAsAlias
+		*out = new(bool)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfigParameters.
+func (in *LDAPBackendConfigParameters) DeepCopy() *LDAPBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfigSpec) DeepCopyInto(out *LDAPBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfigSpec.
+func (in *LDAPBackendConfigSpec) DeepCopy() *LDAPBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPBackendConfigStatus) DeepCopyInto(out *LDAPBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPBackendConfigStatus.
+func (in *LDAPBackendConfigStatus) DeepCopy() *LDAPBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroup) DeepCopyInto(out *LDAPGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroup.
+func (in *LDAPGroup) DeepCopy() *LDAPGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupList) DeepCopyInto(out *LDAPGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupList.
+func (in *LDAPGroupList) DeepCopy() *LDAPGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupObservation) DeepCopyInto(out *LDAPGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupObservation.
+func (in *LDAPGroupObservation) DeepCopy() *LDAPGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupParameters) DeepCopyInto(out *LDAPGroupParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupParameters.
+func (in *LDAPGroupParameters) DeepCopy() *LDAPGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupSpec) DeepCopyInto(out *LDAPGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupSpec.
+func (in *LDAPGroupSpec) DeepCopy() *LDAPGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPGroupStatus) DeepCopyInto(out *LDAPGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPGroupStatus.
+func (in *LDAPGroupStatus) DeepCopy() *LDAPGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUser) DeepCopyInto(out *LDAPUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUser.
+func (in *LDAPUser) DeepCopy() *LDAPUser {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUserList) DeepCopyInto(out *LDAPUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUserList.
+func (in *LDAPUserList) DeepCopy() *LDAPUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUserObservation) DeepCopyInto(out *LDAPUserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUserObservation.
+func (in *LDAPUserObservation) DeepCopy() *LDAPUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUserParameters) DeepCopyInto(out *LDAPUserParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUserParameters.
+func (in *LDAPUserParameters) DeepCopy() *LDAPUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUserSpec) DeepCopyInto(out *LDAPUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUserSpec.
+func (in *LDAPUserSpec) DeepCopy() *LDAPUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPUserStatus) DeepCopyInto(out *LDAPUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPUserStatus.
+func (in *LDAPUserStatus) DeepCopy() *LDAPUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfig) DeepCopyInto(out *OktaBackendConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfig.
+func (in *OktaBackendConfig) DeepCopy() *OktaBackendConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaBackendConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfigList) DeepCopyInto(out *OktaBackendConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OktaBackendConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfigList.
+func (in *OktaBackendConfigList) DeepCopy() *OktaBackendConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaBackendConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfigObservation) DeepCopyInto(out *OktaBackendConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfigObservation.
+func (in *OktaBackendConfigObservation) DeepCopy() *OktaBackendConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfigParameters) DeepCopyInto(out *OktaBackendConfigParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.APITokenSecretRef != nil {
+		in, out := &in.APITokenSecretRef, &out.APITokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.BaseURL != nil {
+		in, out := &in.BaseURL, &out.BaseURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.BypassOktaMFA != nil {
+		in, out := &in.BypassOktaMFA, &out.BypassOktaMFA
+		*out = new(bool)
+		**out = **in
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfigParameters.
+func (in *OktaBackendConfigParameters) DeepCopy() *OktaBackendConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfigSpec) DeepCopyInto(out *OktaBackendConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfigSpec.
+func (in *OktaBackendConfigSpec) DeepCopy() *OktaBackendConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaBackendConfigStatus) DeepCopyInto(out *OktaBackendConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaBackendConfigStatus.
+func (in *OktaBackendConfigStatus) DeepCopy() *OktaBackendConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaBackendConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroup) DeepCopyInto(out *OktaGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroup.
+func (in *OktaGroup) DeepCopy() *OktaGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroupList) DeepCopyInto(out *OktaGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OktaGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroupList.
+func (in *OktaGroupList) DeepCopy() *OktaGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroupObservation) DeepCopyInto(out *OktaGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroupObservation.
+func (in *OktaGroupObservation) DeepCopy() *OktaGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroupParameters) DeepCopyInto(out *OktaGroupParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroupParameters.
+func (in *OktaGroupParameters) DeepCopy() *OktaGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroupSpec) DeepCopyInto(out *OktaGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroupSpec.
+func (in *OktaGroupSpec) DeepCopy() *OktaGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaGroupStatus) DeepCopyInto(out *OktaGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaGroupStatus.
+func (in *OktaGroupStatus) DeepCopy() *OktaGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUser) DeepCopyInto(out *OktaUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUser.
+func (in *OktaUser) DeepCopy() *OktaUser {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUserList) DeepCopyInto(out *OktaUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OktaUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUserList.
+func (in *OktaUserList) DeepCopy() *OktaUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OktaUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUserObservation) DeepCopyInto(out *OktaUserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUserObservation.
+func (in *OktaUserObservation) DeepCopy() *OktaUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUserParameters) DeepCopyInto(out *OktaUserParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUserParameters.
+func (in *OktaUserParameters) DeepCopy() *OktaUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUserSpec) DeepCopyInto(out *OktaUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUserSpec.
+func (in *OktaUserSpec) DeepCopy() *OktaUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaUserStatus) DeepCopyInto(out *OktaUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaUserStatus.
+func (in *OktaUserStatus) DeepCopy() *OktaUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Role) DeepCopyInto(out *Role) {
 	*out = *in
@@ -87,6 +3568,20 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleObservation) DeepCopyInto(out *RoleObservation) {
 	*out = *in
+	if in.AcceptedRedirectURIs != nil {
+		in, out := &in.AcceptedRedirectURIs, &out.AcceptedRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RejectedRedirectURIs != nil {
+		in, out := &in.RejectedRedirectURIs, &out.RejectedRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedirectURIsVerifiedTime != nil {
+		in, out := &in.RedirectURIsVerifiedTime, &out.RedirectURIsVerifiedTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleObservation.
@@ -196,6 +3691,56 @@ func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 		*out = new(int)
 		**out = **in
 	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
+func (in *RoleParameters) DeepCopy() *RoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenParameters) DeepCopyInto(out *TokenParameters) {
+	*out = *in
 	if in.TokenTTL != nil {
 		in, out := &in.TokenTTL, &out.TokenTTL
 		*out = new(int)
@@ -243,46 +3788,306 @@ func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
-func (in *RoleParameters) DeepCopy() *RoleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenParameters.
+func (in *TokenParameters) DeepCopy() *TokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleParameters)
+	out := new(TokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+func (in *TokenRole) DeepCopyInto(out *TokenRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRole.
+func (in *TokenRole) DeepCopy() *TokenRole {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRoleList) DeepCopyInto(out *TokenRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TokenRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleList.
+func (in *TokenRoleList) DeepCopy() *TokenRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRoleObservation) DeepCopyInto(out *TokenRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleObservation.
+func (in *TokenRoleObservation) DeepCopy() *TokenRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRoleParameters) DeepCopyInto(out *TokenRoleParameters) {
+	*out = *in
+	if in.AllowedPolicies != nil {
+		in, out := &in.AllowedPolicies, &out.AllowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisallowedPolicies != nil {
+		in, out := &in.DisallowedPolicies, &out.DisallowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPoliciesGlob != nil {
+		in, out := &in.AllowedPoliciesGlob, &out.AllowedPoliciesGlob
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisallowedPoliciesGlob != nil {
+		in, out := &in.DisallowedPoliciesGlob, &out.DisallowedPoliciesGlob
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Orphan != nil {
+		in, out := &in.Orphan, &out.Orphan
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Renewable != nil {
+		in, out := &in.Renewable, &out.Renewable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PathSuffix != nil {
+		in, out := &in.PathSuffix, &out.PathSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedEntityAliases != nil {
+		in, out := &in.AllowedEntityAliases, &out.AllowedEntityAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleParameters.
+func (in *TokenRoleParameters) DeepCopy() *TokenRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRoleSpec) DeepCopyInto(out *TokenRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
-func (in *RoleSpec) DeepCopy() *RoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleSpec.
+func (in *TokenRoleSpec) DeepCopy() *TokenRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleSpec)
+	out := new(TokenRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+func (in *TokenRoleStatus) DeepCopyInto(out *TokenRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
-func (in *RoleStatus) DeepCopy() *RoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRoleStatus.
+func (in *TokenRoleStatus) DeepCopy() *TokenRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleStatus)
+	out := new(TokenRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUser) DeepCopyInto(out *UserpassUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUser.
+func (in *UserpassUser) DeepCopy() *UserpassUser {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserpassUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUserList) DeepCopyInto(out *UserpassUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserpassUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserList.
+func (in *UserpassUserList) DeepCopy() *UserpassUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserpassUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUserObservation) DeepCopyInto(out *UserpassUserObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserObservation.
+func (in *UserpassUserObservation) DeepCopy() *UserpassUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUserParameters) DeepCopyInto(out *UserpassUserParameters) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	out.PasswordSecretRef = in.PasswordSecretRef
+	in.TokenParameters.DeepCopyInto(&out.TokenParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserParameters.
+func (in *UserpassUserParameters) DeepCopy() *UserpassUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUserSpec) DeepCopyInto(out *UserpassUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserSpec.
+func (in *UserpassUserSpec) DeepCopy() *UserpassUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserpassUserStatus) DeepCopyInto(out *UserpassUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserpassUserStatus.
+func (in *UserpassUserStatus) DeepCopy() *UserpassUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserpassUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
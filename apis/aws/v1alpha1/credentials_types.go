@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CredentialsParameters are the configurable fields of a Credentials.
+type CredentialsParameters struct {
+	// Backend - (Required) The path the AWS secret backend is mounted at, with no leading or trailing /s.
+	// +required
+	Backend string `json:"authBackend"`
+
+	// Role - (Required) The name of the Vault role to generate credentials against.
+	// +required
+	Role string `json:"role"`
+
+	// Endpoint - (Optional) The endpoint used to generate the credentials: creds (default) reads <backend>/creds/<role>,
+	// sts reads <backend>/sts/<role>, which is only valid for assumed_role and federation_token roles.
+	// +optional
+	// +kubebuilder:default:=creds
+	// +kubebuilder:validation:Enum:=creds;sts
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// TTL - (Optional) The TTL in seconds of the generated credentials. It is also used as the increment when the lease is renewed.
+	// When not set, the default TTL of the role or backend is used.
+	// +optional
+	TTL int `json:"ttl,omitempty"`
+
+	// RoleArn - (Optional) The ARN of the role to assume, required when the Vault role has more than one role_arns.
+	// Valid only for assumed_role credentials.
+	// +optional
+	RoleArn string `json:"roleArn,omitempty"`
+
+	// RenewBefore - (Optional) How many seconds before the lease expires it is renewed, or the credentials re-issued
+	// when the lease is not renewable. Defaults to a third of the lease duration.
+	// +optional
+	RenewBefore int `json:"renewBefore,omitempty"`
+}
+
+// CredentialsObservation are the observable fields of a Credentials.
+type CredentialsObservation struct {
+	// LeaseID is the ID of the lease backing the current credentials.
+	LeaseID string `json:"leaseId,omitempty"`
+
+	// LeaseDuration is the total duration, in seconds, of the lease since it was issued or last renewed.
+	LeaseDuration int `json:"leaseDuration,omitempty"`
+
+	// Renewable tells whether the lease can be renewed or the credentials must be re-issued.
+	Renewable bool `json:"renewable,omitempty"`
+
+	// ExpireTime is when the lease expires.
+	ExpireTime *metav1.Time `json:"expireTime,omitempty"`
+}
+
+// A CredentialsSpec defines the desired state of a Credentials.
+type CredentialsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CredentialsParameters `json:"forProvider"`
+}
+
+// A CredentialsStatus represents the observed state of a Credentials.
+type CredentialsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CredentialsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Credentials is a set of dynamic AWS credentials issued by Vault. The
+// access key, secret key and session token are published as connection
+// details and the lease is renewed, or the credentials re-issued, before it
+// expires. The external name of a Credentials is its lease ID.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXPIRE-TIME",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type Credentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CredentialsSpec   `json:"spec"`
+	Status CredentialsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CredentialsList contains a list of Credentials
+type CredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Credentials `json:"items"`
+}
+
+// Credentials type metadata.
+var (
+	CredentialsKind             = reflect.TypeOf(Credentials{}).Name()
+	CredentialsGroupKind        = schema.GroupKind{Group: Group, Kind: CredentialsKind}.String()
+	CredentialsKindAPIVersion   = CredentialsKind + "." + SchemeGroupVersion.String()
+	CredentialsGroupVersionKind = SchemeGroupVersion.WithKind(CredentialsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Credentials{}, &CredentialsList{})
+}
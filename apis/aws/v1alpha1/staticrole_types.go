@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// StaticRoleParameters are the configurable fields of a StaticRole.
+type StaticRoleParameters struct {
+	// Backend - (Required) The path the AWS secret backend is mounted at, with no leading or trailing /s.
+	// +required
+	Backend string `json:"authBackend"`
+
+	// Username - (Required) The name of the existing IAM user whose access keys are managed by Vault.
+	// +required
+	Username string `json:"username"`
+
+	// RotationPeriod - (Required) How often, in seconds, Vault rotates the access key of the IAM user. The minimum is 60 seconds.
+	// +required
+	// +kubebuilder:validation:Minimum:=60
+	RotationPeriod int `json:"rotationPeriod"`
+}
+
+// StaticRoleObservation are the observable fields of a StaticRole.
+type StaticRoleObservation struct {
+	// AccessKeyID is the ID of the access key currently issued by Vault for the IAM user.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+}
+
+// A StaticRoleSpec defines the desired state of a StaticRole.
+type StaticRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StaticRoleParameters `json:"forProvider"`
+}
+
+// A StaticRoleStatus represents the observed state of a StaticRole.
+type StaticRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StaticRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StaticRole is an AWS secret backend static role. The access key pair of
+// its IAM user is published as connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type StaticRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticRoleSpec   `json:"spec"`
+	Status StaticRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticRoleList contains a list of StaticRole
+type StaticRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticRole `json:"items"`
+}
+
+// StaticRole type metadata.
+var (
+	StaticRoleKind             = reflect.TypeOf(StaticRole{}).Name()
+	StaticRoleGroupKind        = schema.GroupKind{Group: Group, Kind: StaticRoleKind}.String()
+	StaticRoleKindAPIVersion   = StaticRoleKind + "." + SchemeGroupVersion.String()
+	StaticRoleGroupVersionKind = SchemeGroupVersion.WithKind(StaticRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StaticRole{}, &StaticRoleList{})
+}
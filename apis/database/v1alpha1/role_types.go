@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Validation Errors
+	errRoleTTL = "defaultTTL cannot be greater than maxTTL"
+)
+
+// RoleParameters are the configurable fields of a Role.
+type RoleParameters struct {
+	// The path the database secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// The name of the database connection the role issues credentials for.
+	// +required
+	DBName string `json:"dbName"`
+
+	// The statements that create the database user, templated with {{name}}, {{password}} and
+	// {{expiration}}. Required by most plugins.
+	// +optional
+	CreationStatements []string `json:"creationStatements,omitempty"`
+
+	// The statements that revoke the database user, templated with {{name}}.
+	// +optional
+	RevocationStatements []string `json:"revocationStatements,omitempty"`
+
+	// The statements that undo a failed creation, templated with {{name}}.
+	// +optional
+	RollbackStatements []string `json:"rollbackStatements,omitempty"`
+
+	// The statements that extend the database user on lease renewal, templated with {{name}}
+	// and {{expiration}}.
+	// +optional
+	RenewStatements []string `json:"renewStatements,omitempty"`
+
+	// The default lease duration of the credentials, in seconds.
+	// +optional
+	DefaultTTL *int `json:"defaultTTL,omitempty"`
+
+	// The maximum lease duration of the credentials, in seconds.
+	// +optional
+	MaxTTL *int `json:"maxTTL,omitempty"`
+
+	// The type of the credentials. Defaults to password.
+	// +optional
+	// +kubebuilder:validation:Enum:=password;rsa_private_key;client_certificate
+	CredentialType *string `json:"credentialType,omitempty"`
+
+	// The configuration of the credentials, which depends on their type, such as the
+	// password_policy of passwords or the key_bits of RSA private keys.
+	// +optional
+	CredentialConfig map[string]string `json:"credentialConfig,omitempty"`
+}
+
+// RoleObservation are the observable fields of a Role.
+type RoleObservation struct {
+}
+
+// A RoleSpec defines the desired state of a Role.
+type RoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RoleParameters `json:"forProvider"`
+}
+
+// A RoleStatus represents the observed state of a Role.
+type RoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Role is a dynamic role of a database secrets engine, stored at
+// <mount>/roles/<external name>. Vault creates a database user from its
+// creation statements for every lease of credentials it issues.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type Role struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleSpec   `json:"spec"`
+	Status RoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RoleList contains a list of Role
+type RoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Role `json:"items"`
+}
+
+// Role type metadata.
+var (
+	RoleKind             = reflect.TypeOf(Role{}).Name()
+	RoleGroupKind        = schema.GroupKind{Group: Group, Kind: RoleKind}.String()
+	RoleKindAPIVersion   = RoleKind + "." + SchemeGroupVersion.String()
+	RoleGroupVersionKind = SchemeGroupVersion.WithKind(RoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Role{}, &RoleList{})
+}
+
+// Validate the role as vault rejects a default TTL greater than the maximum
+func (r *Role) Validate() error {
+	p := r.Spec.ForProvider
+	if p.DefaultTTL != nil && p.MaxTTL != nil && *p.MaxTTL > 0 && *p.DefaultTTL > *p.MaxTTL {
+		return errors.New(errRoleTTL)
+	}
+	return nil
+}
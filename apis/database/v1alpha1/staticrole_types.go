@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// Validation Errors
+	errRotation       = "exactly one of rotationPeriod and rotationSchedule must be set"
+	errRotationWindow = "rotationWindow can only be set along with rotationSchedule"
+)
+
+// StaticRoleParameters are the configurable fields of a StaticRole.
+type StaticRoleParameters struct {
+	// The path the database secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// The name of the database connection the role manages the user of.
+	// +required
+	DBName string `json:"dbName"`
+
+	// The name of the existing database user. Vault does not allow changing it.
+	// +required
+	Username string `json:"username"`
+
+	// How often, in seconds, vault rotates the password. Exactly one of rotationPeriod and
+	// rotationSchedule must be set.
+	// +optional
+	// +kubebuilder:validation:Minimum:=5
+	RotationPeriod *int `json:"rotationPeriod,omitempty"`
+
+	// The cron style schedule vault rotates the password on, such as "0 0 * * SAT".
+	// +optional
+	RotationSchedule *string `json:"rotationSchedule,omitempty"`
+
+	// How long, in seconds, vault may take to rotate the password once the schedule is
+	// due. Only used along with rotationSchedule.
+	// +optional
+	RotationWindow *int `json:"rotationWindow,omitempty"`
+
+	// The statements that change the password, templated with {{name}} and {{password}}.
+	// +optional
+	RotationStatements []string `json:"rotationStatements,omitempty"`
+
+	// The type of the credentials. Defaults to password.
+	// +optional
+	// +kubebuilder:validation:Enum:=password;rsa_private_key;client_certificate
+	CredentialType *string `json:"credentialType,omitempty"`
+
+	// The configuration of the credentials, which depends on their type.
+	// +optional
+	CredentialConfig map[string]string `json:"credentialConfig,omitempty"`
+}
+
+// StaticRoleObservation are the observable fields of a StaticRole.
+type StaticRoleObservation struct {
+	// LastVaultRotation is when vault last rotated the password, in RFC3339.
+	LastVaultRotation string `json:"lastVaultRotation,omitempty"`
+}
+
+// A StaticRoleSpec defines the desired state of a StaticRole.
+type StaticRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StaticRoleParameters `json:"forProvider"`
+}
+
+// A StaticRoleStatus represents the observed state of a StaticRole.
+type StaticRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StaticRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StaticRole is a static role of a database secrets engine, stored at
+// <mount>/static-roles/<external name>. Vault rotates the password of an
+// existing database user, which is published as connection details along
+// with its username.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type StaticRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticRoleSpec   `json:"spec"`
+	Status StaticRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticRoleList contains a list of StaticRole
+type StaticRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticRole `json:"items"`
+}
+
+// StaticRole type metadata.
+var (
+	StaticRoleKind             = reflect.TypeOf(StaticRole{}).Name()
+	StaticRoleGroupKind        = schema.GroupKind{Group: Group, Kind: StaticRoleKind}.String()
+	StaticRoleKindAPIVersion   = StaticRoleKind + "." + SchemeGroupVersion.String()
+	StaticRoleGroupVersionKind = SchemeGroupVersion.WithKind(StaticRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StaticRole{}, &StaticRoleList{})
+}
+
+// Validate the static role as exactly one way of rotating the password is allowed
+func (r *StaticRole) Validate() error {
+	p := r.Spec.ForProvider
+	switch {
+	case (p.RotationPeriod == nil) == (p.RotationSchedule == nil):
+		return errors.New(errRotation)
+	case p.RotationWindow != nil && p.RotationSchedule == nil:
+		return errors.New(errRotationWindow)
+	}
+	return nil
+}
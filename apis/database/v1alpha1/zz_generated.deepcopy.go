@@ -0,0 +1,649 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Connection) DeepCopyInto(out *Connection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connection.
+func (in *Connection) DeepCopy() *Connection {
+	if in == nil {
+		return nil
+	}
+	out := new(Connection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Connection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionList) DeepCopyInto(out *ConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Connection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionList.
+func (in *ConnectionList) DeepCopy() *ConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionObservation) DeepCopyInto(out *ConnectionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionObservation.
+func (in *ConnectionObservation) DeepCopy() *ConnectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionParameters) DeepCopyInto(out *ConnectionParameters) {
+	*out = *in
+	if in.AllowedRoles != nil {
+		in, out := &in.AllowedRoles, &out.AllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifyConnection != nil {
+		in, out := &in.VerifyConnection, &out.VerifyConnection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotateRoot != nil {
+		in, out := &in.RotateRoot, &out.RotateRoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PostgreSQL != nil {
+		in, out := &in.PostgreSQL, &out.PostgreSQL
+		*out = new(SQLConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MySQL != nil {
+		in, out := &in.MySQL, &out.MySQL
+		*out = new(MySQLConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MongoDB != nil {
+		in, out := &in.MongoDB, &out.MongoDB
+		*out = new(MongoDBConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisConnection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionParameters.
+func (in *ConnectionParameters) DeepCopy() *ConnectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSpec.
+func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStatus.
+func (in *ConnectionStatus) DeepCopy() *ConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credentials) DeepCopyInto(out *Credentials) {
+	*out = *in
+	if in.UsernameSecretRef != nil {
+		in, out := &in.UsernameSecretRef, &out.UsernameSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credentials.
+func (in *Credentials) DeepCopy() *Credentials {
+	if in == nil {
+		return nil
+	}
+	out := new(Credentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBConnection) DeepCopyInto(out *MongoDBConnection) {
+	*out = *in
+	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.WriteConcern != nil {
+		in, out := &in.WriteConcern, &out.WriteConcern
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsernameTemplate != nil {
+		in, out := &in.UsernameTemplate, &out.UsernameTemplate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBConnection.
+func (in *MongoDBConnection) DeepCopy() *MongoDBConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLConnection) DeepCopyInto(out *MySQLConnection) {
+	*out = *in
+	in.SQLConnection.DeepCopyInto(&out.SQLConnection)
+	if in.PluginName != nil {
+		in, out := &in.PluginName, &out.PluginName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLConnection.
+func (in *MySQLConnection) DeepCopy() *MySQLConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisConnection) DeepCopyInto(out *RedisConnection) {
+	*out = *in
+	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InsecureTLS != nil {
+		in, out := &in.InsecureTLS, &out.InsecureTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisConnection.
+func (in *RedisConnection) DeepCopy() *RedisConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleObservation) DeepCopyInto(out *RoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleObservation.
+func (in *RoleObservation) DeepCopy() *RoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
+	*out = *in
+	if in.CreationStatements != nil {
+		in, out := &in.CreationStatements, &out.CreationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevocationStatements != nil {
+		in, out := &in.RevocationStatements, &out.RevocationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RollbackStatements != nil {
+		in, out := &in.RollbackStatements, &out.RollbackStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RenewStatements != nil {
+		in, out := &in.RenewStatements, &out.RenewStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(int)
+		**out = **in
+	}
+	if in.CredentialType != nil {
+		in, out := &in.CredentialType, &out.CredentialType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialConfig != nil {
+		in, out := &in.CredentialConfig, &out.CredentialConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
+func (in *RoleParameters) DeepCopy() *RoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLConnection) DeepCopyInto(out *SQLConnection) {
+	*out = *in
+	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.MaxOpenConnections != nil {
+		in, out := &in.MaxOpenConnections, &out.MaxOpenConnections
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxIdleConnections != nil {
+		in, out := &in.MaxIdleConnections, &out.MaxIdleConnections
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxConnectionLifetime != nil {
+		in, out := &in.MaxConnectionLifetime, &out.MaxConnectionLifetime
+		*out = new(int)
+		**out = **in
+	}
+	if in.UsernameTemplate != nil {
+		in, out := &in.UsernameTemplate, &out.UsernameTemplate
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLConnection.
+func (in *SQLConnection) DeepCopy() *SQLConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRole) DeepCopyInto(out *StaticRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRole.
+func (in *StaticRole) DeepCopy() *StaticRole {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoleList) DeepCopyInto(out *StaticRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StaticRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoleList.
+func (in *StaticRoleList) DeepCopy() *StaticRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoleObservation) DeepCopyInto(out *StaticRoleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoleObservation.
+func (in *StaticRoleObservation) DeepCopy() *StaticRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoleParameters) DeepCopyInto(out *StaticRoleParameters) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(int)
+		**out = **in
+	}
+	if in.RotationSchedule != nil {
+		in, out := &in.RotationSchedule, &out.RotationSchedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationWindow != nil {
+		in, out := &in.RotationWindow, &out.RotationWindow
+		*out = new(int)
+		**out = **in
+	}
+	if in.RotationStatements != nil {
+		in, out := &in.RotationStatements, &out.RotationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialType != nil {
+		in, out := &in.CredentialType, &out.CredentialType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialConfig != nil {
+		in, out := &in.CredentialConfig, &out.CredentialConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoleParameters.
+func (in *StaticRoleParameters) DeepCopy() *StaticRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoleSpec) DeepCopyInto(out *StaticRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoleSpec.
+func (in *StaticRoleSpec) DeepCopy() *StaticRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoleStatus) DeepCopyInto(out *StaticRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoleStatus.
+func (in *StaticRoleStatus) DeepCopy() *StaticRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretMetadataParameters are the configurable fields of a SecretMetadata.
+type SecretMetadataParameters struct {
+	// The path the KV version 2 secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// The number of versions kept for the secret. Vault uses the max_versions of the engine when
+	// unset or 0.
+	// +optional
+	MaxVersions *int `json:"maxVersions,omitempty"`
+
+	// Whether every write of the secret must be checked and set against its current version. Writes
+	// are always checked when the engine requires it.
+	// +optional
+	CASRequired *bool `json:"casRequired,omitempty"`
+
+	// The number of seconds after which versions of the secret are deleted. Vault uses the
+	// delete_version_after of the engine when unset or 0.
+	// +optional
+	DeleteVersionAfter *int `json:"deleteVersionAfter,omitempty"`
+
+	// Arbitrary key/value pairs describing the secret, such as its owner. They are not secret.
+	// +optional
+	CustomMetadata map[string]string `json:"customMetadata,omitempty"`
+
+	// Whether deleting the SecretMetadata permanently deletes the secret along with all of its
+	// versions and metadata. Defaults to false, which leaves the secret in place.
+	// +optional
+	PurgeOnDelete *bool `json:"purgeOnDelete,omitempty"`
+}
+
+// SecretMetadataObservation are the observable fields of a SecretMetadata.
+type SecretMetadataObservation struct {
+	// The current version of the secret, 0 when it was never written.
+	CurrentVersion int `json:"currentVersion,omitempty"`
+
+	// The oldest version of the secret that is kept.
+	OldestVersion int `json:"oldestVersion,omitempty"`
+
+	// When the secret was created.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+
+	// When the secret was last written.
+	UpdatedTime *metav1.Time `json:"updatedTime,omitempty"`
+}
+
+// A SecretMetadataSpec defines the desired state of a SecretMetadata.
+type SecretMetadataSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretMetadataParameters `json:"forProvider"`
+}
+
+// A SecretMetadataStatus represents the observed state of a SecretMetadata.
+type SecretMetadataStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecretMetadataObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecretMetadata is the metadata of a secret of a KV version 2 secrets
+// engine, stored at <mount>/metadata/<external name>. It sets how the versions
+// of the secret are kept and written, whoever writes them. Deleting it leaves
+// the secret in place unless it is set to purge it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type SecretMetadata struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretMetadataSpec   `json:"spec"`
+	Status SecretMetadataStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretMetadataList contains a list of SecretMetadata
+type SecretMetadataList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretMetadata `json:"items"`
+}
+
+// SecretMetadata type metadata.
+var (
+	SecretMetadataKind             = reflect.TypeOf(SecretMetadata{}).Name()
+	SecretMetadataGroupKind        = schema.GroupKind{Group: Group, Kind: SecretMetadataKind}.String()
+	SecretMetadataKindAPIVersion   = SecretMetadataKind + "." + SchemeGroupVersion.String()
+	SecretMetadataGroupVersionKind = SchemeGroupVersion.WithKind(SecretMetadataKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecretMetadata{}, &SecretMetadataList{})
+}
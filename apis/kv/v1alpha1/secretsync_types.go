@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretSyncParameters are the configurable fields of a SecretSync.
+type SecretSyncParameters struct {
+	// The path the KV secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// The version of the KV secrets engine mounted at mount. Defaults to 2.
+	// +optional
+	// +kubebuilder:default:=2
+	// +kubebuilder:validation:Enum:=1;2
+	EngineVersion *int `json:"engineVersion,omitempty"`
+
+	// The version of the secret to publish, instead of its current version. Only valid with KV
+	// version 2.
+	// +optional
+	Version *int `json:"version,omitempty"`
+
+	// The keys of the secret to publish. All of them are published when unset.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
+// SecretSyncObservation are the observable fields of a SecretSync.
+type SecretSyncObservation struct {
+	// The version of the secret published. Only reported with KV version 2.
+	Version int `json:"version,omitempty"`
+
+	// The keys of the secret published as connection details.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// A SecretSyncSpec defines the desired state of a SecretSync.
+type SecretSyncSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretSyncParameters `json:"forProvider"`
+}
+
+// A SecretSyncStatus represents the observed state of a SecretSync.
+type SecretSyncStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecretSyncObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecretSync publishes the data of a secret of a KV secrets engine, stored
+// at <mount>/<external name> or <mount>/data/<external name>, as connection
+// details. It only reads the secret, which is read again on every poll so
+// that the connection secret follows its changes, and deleting it leaves the
+// secret in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type SecretSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretSyncSpec   `json:"spec"`
+	Status SecretSyncStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretSyncList contains a list of SecretSync
+type SecretSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretSync `json:"items"`
+}
+
+// SecretSync type metadata.
+var (
+	SecretSyncKind             = reflect.TypeOf(SecretSync{}).Name()
+	SecretSyncGroupKind        = schema.GroupKind{Group: Group, Kind: SecretSyncKind}.String()
+	SecretSyncKindAPIVersion   = SecretSyncKind + "." + SchemeGroupVersion.String()
+	SecretSyncGroupVersionKind = SchemeGroupVersion.WithKind(SecretSyncKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecretSync{}, &SecretSyncList{})
+}
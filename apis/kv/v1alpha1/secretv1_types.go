@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretV1Parameters are the configurable fields of a SecretV1.
+type SecretV1Parameters struct {
+	// The path the KV version 1 secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// Non-sensitive key/value pairs of the secret, written as they are.
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+
+	// Key/value pairs of the secret whose values are read from Kubernetes Secrets. They take
+	// precedence over the keys of data.
+	// +optional
+	DataSecretRefs []SecretDataRef `json:"dataSecretRefs,omitempty"`
+}
+
+// A SecretV1Spec defines the desired state of a SecretV1.
+type SecretV1Spec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretV1Parameters `json:"forProvider"`
+}
+
+// A SecretV1Status represents the observed state of a SecretV1.
+type SecretV1Status struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecretV1 is a secret of a KV version 1 secrets engine, stored at
+// <mount>/<external name>. Its values are compared with the ones vault holds
+// on each observation, and are never recorded in the status.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type SecretV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretV1Spec   `json:"spec"`
+	Status SecretV1Status `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretV1List contains a list of SecretV1
+type SecretV1List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretV1 `json:"items"`
+}
+
+// SecretV1 type metadata.
+var (
+	SecretV1Kind             = reflect.TypeOf(SecretV1{}).Name()
+	SecretV1GroupKind        = schema.GroupKind{Group: Group, Kind: SecretV1Kind}.String()
+	SecretV1KindAPIVersion   = SecretV1Kind + "." + SchemeGroupVersion.String()
+	SecretV1GroupVersionKind = SchemeGroupVersion.WithKind(SecretV1Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecretV1{}, &SecretV1List{})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretV2Parameters are the configurable fields of a SecretV2.
+type SecretV2Parameters struct {
+	// The path the KV version 2 secrets engine is mounted at, with no leading or trailing /s.
+	// +required
+	Mount string `json:"mount"`
+
+	// Non-sensitive key/value pairs of the secret, written as they are.
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+
+	// Key/value pairs of the secret whose values are read from Kubernetes Secrets. They take
+	// precedence over the keys of data.
+	// +optional
+	DataSecretRefs []SecretDataRef `json:"dataSecretRefs,omitempty"`
+
+	// What deleting the SecretV2 does to the secret: SoftDelete marks its current version as
+	// deleted, which can be undone with vault kv undelete, and Destroy permanently removes the data
+	// of all its versions. The metadata of the secret is left in place either way.
+	// +optional
+	// +kubebuilder:default:=SoftDelete
+	// +kubebuilder:validation:Enum:=SoftDelete;Destroy
+	DeletionMode *string `json:"deletionMode,omitempty"`
+}
+
+// A SecretDataRef draws the value of a key of a secret from a key of a
+// Kubernetes Secret.
+type SecretDataRef struct {
+	// The key of the secret in vault.
+	Key string `json:"key"`
+
+	// A reference to the key of the Kubernetes Secret holding the value.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// SecretV2Observation are the observable fields of a SecretV2.
+type SecretV2Observation struct {
+	// The current version of the secret, which the next write is checked and set against.
+	Version int `json:"version,omitempty"`
+
+	// When the current version was written.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+
+	// When the current version is deleted, as set by the delete_version_after of the secret or
+	// the engine.
+	DeletionTime *metav1.Time `json:"deletionTime,omitempty"`
+}
+
+// A SecretV2Spec defines the desired state of a SecretV2.
+type SecretV2Spec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretV2Parameters `json:"forProvider"`
+}
+
+// A SecretV2Status represents the observed state of a SecretV2.
+type SecretV2Status struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecretV2Observation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecretV2 is a secret of a KV version 2 secrets engine, stored at
+// <mount>/data/<external name>. Every change writes a new version of the
+// secret, checked and set against the version last observed so that writes
+// made meanwhile by others are not clobbered.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,vault}
+type SecretV2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretV2Spec   `json:"spec"`
+	Status SecretV2Status `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretV2List contains a list of SecretV2
+type SecretV2List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretV2 `json:"items"`
+}
+
+// SecretV2 type metadata.
+var (
+	SecretV2Kind             = reflect.TypeOf(SecretV2{}).Name()
+	SecretV2GroupKind        = schema.GroupKind{Group: Group, Kind: SecretV2Kind}.String()
+	SecretV2KindAPIVersion   = SecretV2Kind + "." + SchemeGroupVersion.String()
+	SecretV2GroupVersionKind = SchemeGroupVersion.WithKind(SecretV2Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecretV2{}, &SecretV2List{})
+}
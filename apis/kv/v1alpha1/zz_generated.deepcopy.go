@@ -0,0 +1,610 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretDataRef) DeepCopyInto(out *SecretDataRef) {
+	*out = *in
+	out.ValueSecretRef = in.ValueSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretDataRef.
+func (in *SecretDataRef) DeepCopy() *SecretDataRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretDataRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadata) DeepCopyInto(out *SecretMetadata) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadata.
+func (in *SecretMetadata) DeepCopy() *SecretMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretMetadata) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadataList) DeepCopyInto(out *SecretMetadataList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadataList.
+func (in *SecretMetadataList) DeepCopy() *SecretMetadataList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadataList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretMetadataList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadataObservation) DeepCopyInto(out *SecretMetadataObservation) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedTime != nil {
+		in, out := &in.UpdatedTime, &out.UpdatedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadataObservation.
+func (in *SecretMetadataObservation) DeepCopy() *SecretMetadataObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadataObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadataParameters) DeepCopyInto(out *SecretMetadataParameters) {
+	*out = *in
+	if in.MaxVersions != nil {
+		in, out := &in.MaxVersions, &out.MaxVersions
+		*out = new(int)
+		**out = **in
+	}
+	if in.CASRequired != nil {
+		in, out := &in.CASRequired, &out.CASRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteVersionAfter != nil {
+		in, out := &in.DeleteVersionAfter, &out.DeleteVersionAfter
+		*out = new(int)
+		**out = **in
+	}
+	if in.CustomMetadata != nil {
+		in, out := &in.CustomMetadata, &out.CustomMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PurgeOnDelete != nil {
+		in, out := &in.PurgeOnDelete, &out.PurgeOnDelete
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadataParameters.
+func (in *SecretMetadataParameters) DeepCopy() *SecretMetadataParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadataParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadataSpec) DeepCopyInto(out *SecretMetadataSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadataSpec.
+func (in *SecretMetadataSpec) DeepCopy() *SecretMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMetadataStatus) DeepCopyInto(out *SecretMetadataStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMetadataStatus.
+func (in *SecretMetadataStatus) DeepCopy() *SecretMetadataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMetadataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSync) DeepCopyInto(out *SecretSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSync.
+func (in *SecretSync) DeepCopy() *SecretSync {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSyncList) DeepCopyInto(out *SecretSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncList.
+func (in *SecretSyncList) DeepCopy() *SecretSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSyncObservation) DeepCopyInto(out *SecretSyncObservation) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncObservation.
+func (in *SecretSyncObservation) DeepCopy() *SecretSyncObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSyncObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSyncParameters) DeepCopyInto(out *SecretSyncParameters) {
+	*out = *in
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(int)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(int)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncParameters.
+func (in *SecretSyncParameters) DeepCopy() *SecretSyncParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSyncParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSyncSpec) DeepCopyInto(out *SecretSyncSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncSpec.
+func (in *SecretSyncSpec) DeepCopy() *SecretSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSyncStatus) DeepCopyInto(out *SecretSyncStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSyncStatus.
+func (in *SecretSyncStatus) DeepCopy() *SecretSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV1) DeepCopyInto(out *SecretV1) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV1.
+func (in *SecretV1) DeepCopy() *SecretV1 {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV1)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretV1) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV1List) DeepCopyInto(out *SecretV1List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretV1, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV1List.
+func (in *SecretV1List) DeepCopy() *SecretV1List {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV1List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretV1List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV1Parameters) DeepCopyInto(out *SecretV1Parameters) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DataSecretRefs != nil {
+		in, out := &in.DataSecretRefs, &out.DataSecretRefs
+		*out = make([]SecretDataRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV1Parameters.
+func (in *SecretV1Parameters) DeepCopy() *SecretV1Parameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV1Parameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV1Spec) DeepCopyInto(out *SecretV1Spec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV1Spec.
+func (in *SecretV1Spec) DeepCopy() *SecretV1Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV1Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV1Status) DeepCopyInto(out *SecretV1Status) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV1Status.
+func (in *SecretV1Status) DeepCopy() *SecretV1Status {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV1Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2) DeepCopyInto(out *SecretV2) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2.
+func (in *SecretV2) DeepCopy() *SecretV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretV2) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2List) DeepCopyInto(out *SecretV2List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretV2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2List.
+func (in *SecretV2List) DeepCopy() *SecretV2List {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretV2List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2Observation) DeepCopyInto(out *SecretV2Observation) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeletionTime != nil {
+		in, out := &in.DeletionTime, &out.DeletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2Observation.
+func (in *SecretV2Observation) DeepCopy() *SecretV2Observation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2Observation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2Parameters) DeepCopyInto(out *SecretV2Parameters) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DataSecretRefs != nil {
+		in, out := &in.DataSecretRefs, &out.DataSecretRefs
+		*out = make([]SecretDataRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2Parameters.
+func (in *SecretV2Parameters) DeepCopy() *SecretV2Parameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2Parameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2Spec) DeepCopyInto(out *SecretV2Spec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2Spec.
+func (in *SecretV2Spec) DeepCopy() *SecretV2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretV2Status) DeepCopyInto(out *SecretV2Status) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretV2Status.
+func (in *SecretV2Status) DeepCopy() *SecretV2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretV2Status)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,6 +20,8 @@ package apis
 import (
 	authv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
 	awsv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	databasev1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	kvv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
 	sysv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/sys/v1alpha1"
 	vaultv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +34,8 @@ func init() {
 		sysv1alpha1.SchemeBuilder.AddToScheme,
 		awsv1alpha1.SchemeBuilder.AddToScheme,
 		authv1alpha1.SchemeBuilder.AddToScheme,
+		kvv1alpha1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
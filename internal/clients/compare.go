@@ -0,0 +1,47 @@
+package clients
+
+import (
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// IgnoreUnset returns a cmp option that ignores the fields of typ whose
+// namesake in params, the parameters of a managed resource, was left unset,
+// that is, is a nil pointer, slice or map. It lets controllers compare only
+// what the user asked for, so that Vault's defaults for the rest are not
+// reported as drift. Fields of typ without a namesake in params are compared.
+// Fields of embedded structs, such as the token parameters shared by auth
+// methods, are matched as if they were fields of params.
+func IgnoreUnset(params interface{}, typ interface{}) cmp.Option {
+	t := reflect.TypeOf(typ)
+	unset := unsetFields(reflect.Indirect(reflect.ValueOf(params)), t)
+
+	if len(unset) == 0 {
+		return cmp.Options{}
+	}
+	return cmpopts.IgnoreFields(typ, unset...)
+}
+
+func unsetFields(v reflect.Value, t reflect.Type) []string {
+	unset := []string{}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		switch v.Field(i).Kind() { // nolint:exhaustive
+		case reflect.Ptr, reflect.Slice, reflect.Map:
+		case reflect.Struct:
+			if f.Anonymous {
+				unset = append(unset, unsetFields(v.Field(i), t)...)
+			}
+			continue
+		default:
+			continue
+		}
+
+		if _, ok := t.FieldByName(f.Name); ok && v.Field(i).IsNil() {
+			unset = append(unset, f.Name)
+		}
+	}
+	return unset
+}
@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type CompareEmbedded struct {
+	TTL *int
+}
+
+type compareParams struct {
+	CompareEmbedded
+	Name     *string
+	Policies []string
+	Enabled  bool
+	Backend  *string
+}
+
+type compareTarget struct {
+	TTL      int
+	Name     string
+	Policies []string
+	Enabled  bool
+}
+
+func TestIgnoreUnset(t *testing.T) {
+	name := "role"
+
+	cases := map[string]struct {
+		reason   string
+		params   compareParams
+		observed compareTarget
+		want     bool
+	}{
+		"unset fields are ignored": {
+			reason:   "fields left unset in the parameters must not be compared",
+			params:   compareParams{Enabled: true},
+			observed: compareTarget{Name: "vault-default", Policies: []string{"default"}, Enabled: true},
+			want:     true,
+		},
+		"unset fields of embedded structs are ignored": {
+			reason:   "fields left unset in embedded parameters must not be compared",
+			params:   compareParams{Name: &name},
+			observed: compareTarget{Name: name, TTL: 3600},
+			want:     true,
+		},
+		"set fields are compared": {
+			reason:   "fields set in the parameters must be compared",
+			params:   compareParams{Name: &name},
+			observed: compareTarget{Name: "other"},
+			want:     false,
+		},
+		"fields that are not pointers are always compared": {
+			reason:   "fields that cannot be left unset must be compared",
+			params:   compareParams{Name: &name, Enabled: false},
+			observed: compareTarget{Name: name, Enabled: true},
+			want:     false,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			desired := compareTarget{Policies: tc.params.Policies, Enabled: tc.params.Enabled}
+			if tc.params.TTL != nil {
+				desired.TTL = *tc.params.TTL
+			}
+			if tc.params.Name != nil {
+				desired.Name = *tc.params.Name
+			}
+			if got := cmp.Equal(desired, tc.observed, IgnoreUnset(tc.params, compareTarget{})); got != tc.want {
+				t.Errorf("\n%s\ncmp.Equal(..., IgnoreUnset(...)): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errDecodeTarget = "decode target must be a non-nil pointer to a struct"
+	errDecodeField  = "cannot decode field %q"
+	errDecodeType   = "cannot decode %T into %s"
+	errDecodeNumber = "cannot decode %q into an integer"
+)
+
+var (
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+	timeType       = reflect.TypeOf(time.Time{})
+)
+
+// DecodeData maps the data of a Vault response into the struct pointed by out.
+// Struct fields are matched with the response keys by their json tag. Vault
+// is not consistent about the types it returns, so the decoding is lenient:
+//   - numbers may be json.Number, float64, integers, numeric strings or
+//     duration strings such as "1h", which are decoded as seconds; fractions
+//     of a second are rejected rather than truncated
+//   - lists may be missing, null, empty, a list or a comma separated string;
+//     missing, null and empty lists are all decoded as nil, and so are maps,
+//     so a decoded response is compared with the parameters of a managed
+//     resource using cmpopts.EquateEmpty
+//   - missing and null values leave the field with its zero value
+//   - fields of embedded structs without a json tag are decoded from the
+//     same data, like encoding/json does
+//
+// An error is returned, instead of panicking, when a value cannot be decoded
+// into its field.
+func DecodeData(data map[string]interface{}, out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New(errDecodeTarget)
+	}
+	return decodeStruct(data, v.Elem())
+}
+
+func decodeStruct(data map[string]interface{}, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported field
+			continue
+		}
+
+		name := fieldName(f)
+		if name == "-" {
+			continue
+		}
+
+		// the fields of embedded structs are decoded from the same data
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && name == f.Name {
+			if err := decodeStruct(data, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := decodeValue(data[name], v.Field(i)); err != nil {
+			return errors.Wrapf(err, errDecodeField, name)
+		}
+	}
+	return nil
+}
+
+func fieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+func decodeValue(in interface{}, v reflect.Value) error { // nolint:gocyclo
+	if in == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch {
+	case v.Type() == jsonNumberType:
+		n, err := toInt64(in)
+		if err != nil {
+			return err
+		}
+		v.SetString(strconv.FormatInt(n, 10))
+		return nil
+	case v.Type() == timeType:
+		s, ok := in.(string)
+		if !ok {
+			return errors.Errorf(errDecodeType, in, v.Type())
+		}
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		tm, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(tm))
+		return nil
+	}
+
+	switch v.Kind() { // nolint:exhaustive
+	case reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		if err := decodeValue(in, p.Elem()); err != nil {
+			return err
+		}
+		v.Set(p)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(in))
+	case reflect.String:
+		s, err := toString(in)
+		if err != nil {
+			return errors.Errorf(errDecodeType, in, v.Type())
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := toBool(in)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := toInt64(in)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return errors.Errorf(errDecodeNumber, fmt.Sprint(in))
+		}
+		v.SetInt(n)
+	case reflect.Slice:
+		return decodeSlice(in, v)
+	case reflect.Map:
+		return decodeMap(in, v)
+	case reflect.Struct:
+		m, ok := in.(map[string]interface{})
+		if !ok {
+			return errors.Errorf(errDecodeType, in, v.Type())
+		}
+		return decodeStruct(m, v)
+	default:
+		return errors.Errorf(errDecodeType, in, v.Type())
+	}
+	return nil
+}
+
+func decodeSlice(in interface{}, v reflect.Value) error {
+	var items []interface{}
+	switch i := in.(type) {
+	case []interface{}:
+		items = i
+	case []string:
+		for _, s := range i {
+			items = append(items, s)
+		}
+	case string:
+		// vault returns some lists as comma separated strings
+		for _, s := range strings.Split(i, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = append(items, s)
+			}
+		}
+	default:
+		return errors.Errorf(errDecodeType, in, v.Type())
+	}
+
+	if len(items) == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	s := reflect.MakeSlice(v.Type(), len(items), len(items))
+	for i, item := range items {
+		if err := decodeValue(item, s.Index(i)); err != nil {
+			return errors.Wrapf(err, "index %d", i)
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+func decodeMap(in interface{}, v reflect.Value) error {
+	if v.Type().Key().Kind() != reflect.String {
+		return errors.Errorf(errDecodeType, in, v.Type())
+	}
+
+	m, ok := in.(map[string]interface{})
+	if !ok {
+		return errors.Errorf(errDecodeType, in, v.Type())
+	}
+
+	if len(m) == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	out := reflect.MakeMapWithSize(v.Type(), len(m))
+	for key, value := range m {
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeValue(value, elem); err != nil {
+			return errors.Wrapf(err, "key %q", key)
+		}
+		out.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+	}
+	v.Set(out)
+	return nil
+}
+
+func toString(in interface{}) (string, error) {
+	switch i := in.(type) {
+	case string:
+		return i, nil
+	case json.Number:
+		return i.String(), nil
+	case bool, int, int64, float64:
+		return fmt.Sprint(i), nil
+	default:
+		return "", errors.Errorf(errDecodeType, in, "string")
+	}
+}
+
+func toBool(in interface{}) (bool, error) {
+	switch i := in.(type) {
+	case bool:
+		return i, nil
+	case string:
+		return strconv.ParseBool(i)
+	default:
+		return false, errors.Errorf(errDecodeType, in, "bool")
+	}
+}
+
+// toInt64 decodes numbers and durations, the latter as seconds
+func toInt64(in interface{}) (int64, error) {
+	switch i := in.(type) {
+	case json.Number:
+		return parseInt64(i.String())
+	case string:
+		return parseInt64(i)
+	case float64:
+		return floatToInt64(i)
+	case float32:
+		return floatToInt64(float64(i))
+	case int:
+		return int64(i), nil
+	case int32:
+		return int64(i), nil
+	case int64:
+		return i, nil
+	default:
+		return 0, errors.Errorf(errDecodeType, in, "integer")
+	}
+}
+
+func parseInt64(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return floatToInt64(f)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d%time.Second != 0 {
+		return 0, errors.Errorf(errDecodeNumber, s)
+	}
+	return int64(d / time.Second), nil
+}
+
+func floatToInt64(f float64) (int64, error) {
+	if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+		return 0, errors.Errorf(errDecodeNumber, strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	return int64(f), nil
+}
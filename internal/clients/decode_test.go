@@ -0,0 +1,161 @@
+package clients
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+type DecodeEmbedded struct {
+	Token string `json:"token"`
+}
+
+type decodeTarget struct {
+	DecodeEmbedded
+	Name     string                 `json:"name"`
+	Enabled  bool                   `json:"enabled"`
+	TTL      int                    `json:"ttl"`
+	MaxTTL   json.Number            `json:"max_ttl"`
+	Period   *int                   `json:"period"`
+	Policies []string               `json:"policies"`
+	CIDRs    []interface{}          `json:"cidrs"`
+	Claims   map[string]string      `json:"claims"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Issued   time.Time              `json:"issued"`
+	Skipped  string                 `json:"-"`
+}
+
+func TestDecodeData(t *testing.T) {
+	period := 60
+	issued := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	type want struct {
+		out decodeTarget
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		data   map[string]interface{}
+		want   want
+	}{
+		"numbers and durations": {
+			reason: "json numbers, floats and duration strings must be decoded as seconds",
+			data: map[string]interface{}{
+				"ttl":     json.Number("3600"),
+				"max_ttl": "1h",
+				"period":  float64(60),
+			},
+			want: want{
+				out: decodeTarget{TTL: 3600, MaxTTL: json.Number("3600"), Period: &period},
+			},
+		},
+		"missing and null values": {
+			reason: "missing and null values must leave fields with their zero value",
+			data: map[string]interface{}{
+				"name":     nil,
+				"policies": nil,
+				"claims":   nil,
+			},
+			want: want{
+				out: decodeTarget{},
+			},
+		},
+		"empty lists and maps": {
+			reason: "empty lists and maps must be decoded as nil, like missing ones",
+			data: map[string]interface{}{
+				"policies": []interface{}{},
+				"cidrs":    "",
+				"claims":   map[string]interface{}{},
+			},
+			want: want{
+				out: decodeTarget{},
+			},
+		},
+		"lists and maps": {
+			reason: "lists, comma separated strings and maps must be decoded element by element",
+			data: map[string]interface{}{
+				"name":     "role",
+				"enabled":  "true",
+				"policies": []interface{}{"default", "admin"},
+				"cidrs":    "10.0.0.0/8, 192.168.0.0/16",
+				"claims":   map[string]interface{}{"sub": "user"},
+				"metadata": map[string]interface{}{"team": "platform"},
+				"issued":   issued.Format(time.RFC3339Nano),
+			},
+			want: want{
+				out: decodeTarget{
+					Name:     "role",
+					Enabled:  true,
+					Policies: []string{"default", "admin"},
+					CIDRs:    []interface{}{"10.0.0.0/8", "192.168.0.0/16"},
+					Claims:   map[string]string{"sub": "user"},
+					Metadata: map[string]interface{}{"team": "platform"},
+					Issued:   issued,
+				},
+			},
+		},
+		"embedded struct": {
+			reason: "fields of embedded structs must be decoded from the same data",
+			data: map[string]interface{}{
+				"name":  "role",
+				"token": "service",
+			},
+			want: want{
+				out: decodeTarget{Name: "role", DecodeEmbedded: DecodeEmbedded{Token: "service"}},
+			},
+		},
+		"unexpected type": {
+			reason: "a value of an unexpected type must return an error instead of panicking",
+			data: map[string]interface{}{
+				"policies": map[string]interface{}{"a": "b"},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"fractional duration": {
+			reason: "a duration that is not a whole number of seconds must return an error instead of being truncated",
+			data: map[string]interface{}{
+				"ttl": "1.5s",
+			},
+			want: want{
+				err: true,
+			},
+		},
+		"not an integer": {
+			reason: "a string that is neither a number nor a duration must return an error",
+			data: map[string]interface{}{
+				"ttl": "forever",
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := decodeTarget{}
+			err := DecodeData(tc.data, &got)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nDecodeData(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if tc.want.err {
+				return
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\nDecodeData(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDecodeDataTarget(t *testing.T) {
+	if err := DecodeData(map[string]interface{}{}, decodeTarget{}); errors.Cause(err).Error() != errDecodeTarget {
+		t.Errorf("DecodeData(...): want error %q, got %v", errDecodeTarget, err)
+	}
+}
@@ -79,6 +79,20 @@ func (mr *MockVaultClientMockRecorder) Sys() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sys", reflect.TypeOf((*MockVaultClient)(nil).Sys))
 }
 
+// Token mocks base method.
+func (m *MockVaultClient) Token() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockVaultClientMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockVaultClient)(nil).Token))
+}
+
 // MockVaultSysClient is a mock of VaultSysClient interface.
 type MockVaultSysClient struct {
 	ctrl     *gomock.Controller
@@ -145,6 +159,35 @@ func (mr *MockVaultSysClientMockRecorder) PutPolicy(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPolicy", reflect.TypeOf((*MockVaultSysClient)(nil).PutPolicy), arg0, arg1)
 }
 
+// Renew mocks base method.
+func (m *MockVaultSysClient) Renew(arg0 string, arg1 int) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", arg0, arg1)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockVaultSysClientMockRecorder) Renew(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockVaultSysClient)(nil).Renew), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockVaultSysClient) Revoke(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockVaultSysClientMockRecorder) Revoke(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockVaultSysClient)(nil).Revoke), arg0)
+}
+
 // MockVaultLogicalClient is a mock of VaultLogicalClient interface.
 type MockVaultLogicalClient struct {
 	ctrl     *gomock.Controller
@@ -198,6 +241,21 @@ func (mr *MockVaultLogicalClientMockRecorder) Read(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockVaultLogicalClient)(nil).Read), arg0)
 }
 
+// ReadWithData mocks base method.
+func (m *MockVaultLogicalClient) ReadWithData(arg0 string, arg1 map[string][]string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithData", arg0, arg1)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithData indicates an expected call of ReadWithData.
+func (mr *MockVaultLogicalClientMockRecorder) ReadWithData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithData", reflect.TypeOf((*MockVaultLogicalClient)(nil).ReadWithData), arg0, arg1)
+}
+
 // Write mocks base method.
 func (m *MockVaultLogicalClient) Write(arg0 string, arg1 map[string]interface{}) (*api.Secret, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,43 @@
+package clients
+
+import "k8s.io/utils/pointer"
+
+// LateInitString fills an unset string parameter with the non-empty value
+// Vault holds. It returns true when the parameter was filled.
+func LateInitString(param **string, vault string) bool {
+	if *param != nil || vault == "" {
+		return false
+	}
+	*param = pointer.String(vault)
+	return true
+}
+
+// LateInitBool fills an unset bool parameter when Vault holds true. It
+// returns true when the parameter was filled.
+func LateInitBool(param **bool, vault bool) bool {
+	if *param != nil || !vault {
+		return false
+	}
+	*param = pointer.Bool(vault)
+	return true
+}
+
+// LateInitInt fills an unset int parameter with the non-zero value Vault
+// holds. It returns true when the parameter was filled.
+func LateInitInt(param **int, vault int) bool {
+	if *param != nil || vault == 0 {
+		return false
+	}
+	*param = pointer.Int(vault)
+	return true
+}
+
+// LateInitStrings fills an unset list parameter with the non-empty list Vault
+// holds. It returns true when the parameter was filled.
+func LateInitStrings(param *[]string, vault []string) bool {
+	if *param != nil || len(vault) == 0 {
+		return false
+	}
+	*param = vault
+	return true
+}
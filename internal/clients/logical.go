@@ -9,6 +9,7 @@ type VaultLogicalClient interface {
 	Write(path string, data map[string]interface{}) (*vault.Secret, error)
 	Delete(path string) (*vault.Secret, error)
 	Read(path string) (*vault.Secret, error)
+	ReadWithData(path string, data map[string][]string) (*vault.Secret, error)
 }
 
 // Logical returns the vault logical subclient
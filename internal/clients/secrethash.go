@@ -0,0 +1,47 @@
+package clients
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretHash returns the hex encoded HMAC-SHA256 of the secret values a
+// controller writes to vault, keyed with the vault token of the provider and
+// the UID of the managed resource. Vault never returns such values, so the
+// hash is recorded in the status to notice when the referenced Secrets
+// change. Unlike a plain hash, it cannot be checked against guessed values by
+// those who can only read the managed resource. A new token changes every
+// hash, so each value is written once more after the token is rotated.
+//
+// Nil values, such as those of secret references left unset, are hashed
+// apart from empty ones. An empty string is returned when all values are nil.
+func SecretHash(token string, mg metav1.Object, values ...[]byte) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write([]byte(mg.GetUID()))
+
+	set := false
+	for _, v := range values {
+		if v == nil {
+			mac.Write([]byte{0})
+			continue
+		}
+		set = true
+
+		// each value is prefixed with its length, so that values cannot
+		// be shifted from one to the next without changing the hash
+		prefix := make([]byte, 9)
+		prefix[0] = 1
+		binary.BigEndian.PutUint64(prefix[1:], uint64(len(v)))
+		mac.Write(prefix)
+		mac.Write(v)
+	}
+
+	if !set {
+		return ""
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
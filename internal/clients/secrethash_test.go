@@ -0,0 +1,60 @@
+package clients
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSecretHash(t *testing.T) {
+	mg := &metav1.ObjectMeta{UID: "3f1c6a2e"}
+	other := &metav1.ObjectMeta{UID: "9b07d4c1"}
+	hash := SecretHash("s.token", mg, []byte("s3cr3t"))
+
+	cases := map[string]struct {
+		reason string
+		got    string
+		same   bool
+	}{
+		"deterministic": {
+			reason: "the same values of the same resource must hash the same",
+			got:    SecretHash("s.token", mg, []byte("s3cr3t")),
+			same:   true,
+		},
+		"other value": {
+			reason: "a changed value must change the hash",
+			got:    SecretHash("s.token", mg, []byte("rotated")),
+		},
+		"other token": {
+			reason: "the hash must be keyed with the token",
+			got:    SecretHash("s.other", mg, []byte("s3cr3t")),
+		},
+		"other resource": {
+			reason: "the hash must be keyed with the UID of the resource",
+			got:    SecretHash("s.token", other, []byte("s3cr3t")),
+		},
+		"shifted values": {
+			reason: "the same bytes split differently across values must not hash the same",
+			got:    SecretHash("s.token", mg, []byte("s3"), []byte("cr3t")),
+		},
+		"extra unset value": {
+			reason: "an additional unset value must change the hash",
+			got:    SecretHash("s.token", mg, []byte("s3cr3t"), nil),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if (tc.got == hash) != tc.same {
+				t.Errorf("\n%s\nSecretHash(...): got %q, first hash %q\n", tc.reason, tc.got, hash)
+			}
+		})
+	}
+
+	if got := SecretHash("s.token", mg, nil, nil); got != "" {
+		t.Errorf("\nno value set must hash to an empty string\nSecretHash(...): got %q\n", got)
+	}
+	if SecretHash("s.token", mg, []byte("s3cr3t"), nil) == SecretHash("s.token", mg, []byte("s3cr3t"), []byte{}) {
+		t.Errorf("\nan unset value must not hash like an empty one\n")
+	}
+}
@@ -1,10 +1,16 @@
 package clients
 
+import (
+	vault "github.com/hashicorp/vault/api"
+)
+
 // VaultSysClient is the interface that wraps the vault Sys subclient
 type VaultSysClient interface {
 	GetPolicy(name string) (string, error)
 	PutPolicy(name string, rules string) error
 	DeletePolicy(name string) error
+	Renew(id string, increment int) (*vault.Secret, error)
+	Revoke(id string) error
 }
 
 // Sys returns the vault sys subclient
@@ -27,6 +27,7 @@ type VaultClientWrapper struct {
 type VaultClient interface {
 	Sys() VaultSysClient
 	Logical() VaultLogicalClient
+	Token() string
 }
 
 // NewVaultClient creates a new Vault client.
@@ -0,0 +1,252 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approle
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotAppRole        = "managed resource is not an AppRole custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errCreation = "cannot create AppRole"
+	errUpdate   = "cannot update AppRole"
+	errDelete   = "cannot delete AppRole"
+	errRead     = "cannot read AppRole"
+	errReadID   = "cannot read AppRole role ID"
+	errDecode   = "error decoding AppRole returned by vault"
+
+	errValidationConstraint = "at least one of bindSecretID, secretIDBoundCIDRs or tokenBoundCIDRs must be set"
+
+	defaultBackend = "approle"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles AppRole managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AppRoleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AppRoleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AppRole{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AppRole)
+	if !ok {
+		return nil, errors.New(errNotAppRole)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe reads the role, late initializing the parameters left unset with
+// the values vault holds and comparing only the ones set, and its role ID.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	role, ok := mg.(*v1alpha1.AppRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAppRole)
+	}
+
+	secret, err := c.client.Logical().Read(rolePath(role))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	lateInitialized := lateInitialize(&role.Spec.ForProvider, vaultData)
+	upToDate := cmp.Equal(*fromCrossplane(role.Spec.ForProvider), *vaultData,
+		cmpopts.EquateEmpty(),
+		clients.IgnoreUnset(role.Spec.ForProvider, VaultAppRole{}))
+
+	roleIDSecret, err := c.client.Logical().Read(rolePath(role) + "/role-id")
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errReadID)
+	}
+	if roleIDSecret != nil {
+		roleID, err := roleIDFromVault(roleIDSecret.Data)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errReadID)
+		}
+		role.Status.AtProvider.RoleID = roleID
+	}
+
+	if upToDate {
+		role.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create a AppRole
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	role, ok := mg.(*v1alpha1.AppRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAppRole)
+	}
+
+	if err := c.writeRole(role); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreation)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update a AppRole by writing it again. Vault keeps its role ID and the
+// secret IDs already issued for it.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	role, ok := mg.(*v1alpha1.AppRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAppRole)
+	}
+
+	if err := c.writeRole(role); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete a AppRole
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	role, ok := mg.(*v1alpha1.AppRole)
+	if !ok {
+		return errors.New(errNotAppRole)
+	}
+
+	c.logger.Debug("Deleting AppRole", "path", rolePath(role))
+	if _, err := c.client.Logical().Delete(rolePath(role)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeRole(role *v1alpha1.AppRole) error {
+	if err := validate(role.Spec.ForProvider); err != nil {
+		return err
+	}
+
+	c.logger.Debug("Creating/Updating AppRole", "path", rolePath(role))
+	_, err := c.client.Logical().Write(rolePath(role), encode(role.Spec.ForProvider))
+	return err
+}
+
+func rolePath(role *v1alpha1.AppRole) string {
+	backend := pointer.StringDeref(role.Spec.ForProvider.Backend, defaultBackend)
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + meta.GetExternalName(role)
+}
@@ -0,0 +1,99 @@
+package approle
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultAppRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultAppRole struct {
+	BindSecretID       bool     `json:"bind_secret_id"`
+	SecretIDBoundCIDRs []string `json:"secret_id_bound_cidrs"`
+	SecretIDNumUses    int      `json:"secret_id_num_uses"`
+	SecretIDTTL        int      `json:"secret_id_ttl"`
+
+	tokenfields.VaultTokenFields
+}
+
+// VaultRoleID is the identifier of an AppRole
+type VaultRoleID struct {
+	RoleID string `json:"role_id"`
+}
+
+// validate checks that a role that does not require a secret ID is bound
+// to some CIDRs, as vault does
+// Check https://developer.hashicorp.com/vault/api-docs/auth/approle#create-update-approle to see vault constraints
+func validate(params v1alpha1.AppRoleParameters) error {
+	if !pointer.BoolDeref(params.BindSecretID, true) && len(params.SecretIDBoundCIDRs) == 0 && len(params.TokenBoundCIDRS) == 0 {
+		return errors.New(errValidationConstraint)
+	}
+	return tokenfields.Validate(params.TokenParameters)
+}
+
+func fromCrossplane(params v1alpha1.AppRoleParameters) *VaultAppRole {
+	return &VaultAppRole{
+		BindSecretID:       pointer.BoolDeref(params.BindSecretID, true),
+		SecretIDBoundCIDRs: params.SecretIDBoundCIDRs,
+		SecretIDNumUses:    pointer.IntDeref(params.SecretIDNumUses, 0),
+		SecretIDTTL:        pointer.IntDeref(params.SecretIDTTL, 0),
+		VaultTokenFields:   tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAppRole, error) {
+	role := &VaultAppRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+func roleIDFromVault(data map[string]interface{}) (string, error) {
+	id := &VaultRoleID{}
+	if err := clients.DecodeData(data, id); err != nil {
+		return "", errors.Wrap(err, errDecode)
+	}
+	return id.RoleID, nil
+}
+
+// encode builds the body of a write to the role. The secret ID settings left
+// unset in the managed resource are not sent, and tokenfields.Encode adds
+// the token parameters.
+func encode(params v1alpha1.AppRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.BindSecretID != nil {
+		data["bind_secret_id"] = *params.BindSecretID
+	}
+	if params.SecretIDBoundCIDRs != nil {
+		data["secret_id_bound_cidrs"] = params.SecretIDBoundCIDRs
+	}
+	if params.SecretIDNumUses != nil {
+		data["secret_id_num_uses"] = *params.SecretIDNumUses
+	}
+	if params.SecretIDTTL != nil {
+		data["secret_id_ttl"] = *params.SecretIDTTL
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.AppRoleParameters, vaultData *VaultAppRole) bool {
+	li := false
+
+	li = clients.LateInitBool(&params.BindSecretID, vaultData.BindSecretID) || li
+	li = clients.LateInitStrings(&params.SecretIDBoundCIDRs, vaultData.SecretIDBoundCIDRs) || li
+	li = clients.LateInitInt(&params.SecretIDNumUses, vaultData.SecretIDNumUses) || li
+	li = clients.LateInitInt(&params.SecretIDTTL, vaultData.SecretIDTTL) || li
+	li = tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields) || li
+
+	return li
+}
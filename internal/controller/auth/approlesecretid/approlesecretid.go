@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approlesecretid
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotAppRoleSecretID = "managed resource is not an AppRoleSecretID custom resource"
+	errNewExternalClient  = "cannot create vault client from config"
+
+	errGenerate   = "cannot generate AppRole secret ID"
+	errNoSecretID = "vault returned no AppRole secret ID"
+	errLookup     = "cannot lookup AppRole secret ID"
+	errReadID     = "cannot read AppRole role ID"
+	errNoRoleID   = "vault returned no role ID, does the AppRole exist?"
+	errDestroy    = "cannot destroy AppRole secret ID"
+	errEncode     = "cannot encode AppRole secret ID metadata"
+
+	defaultBackend = "approle"
+)
+
+// Connection details published for an AppRoleSecretID.
+const (
+	ConnectionKeySecretID         = "secret_id"
+	ConnectionKeySecretIDAccessor = "secret_id_accessor"
+	ConnectionKeyRoleID           = "role_id"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles AppRoleSecretID managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AppRoleSecretIDGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AppRoleSecretIDGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		// The external name is the accessor of the secret ID, which is only
+		// known once it is generated.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AppRoleSecretID{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleSecretID)
+	if !ok {
+		return nil, errors.New(errNotAppRoleSecretID)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe looks the secret ID up by its accessor. A secret ID that vault does
+// not know anymore, or that expired, is reported as not existing, so that a
+// new one is generated. Secret IDs cannot be changed, so an existing one is
+// always up to date.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleSecretID)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAppRoleSecretID)
+	}
+
+	accessorID := meta.GetExternalName(cr)
+	if accessorID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	secret, err := c.client.Logical().Write(accessorPath(cr.Spec.ForProvider, "lookup"), map[string]interface{}{"secret_id_accessor": accessorID})
+	if err != nil && !isGone(err) {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	if err != nil || secret == nil {
+		c.logger.Debug("Secret ID is gone", "accessor", accessorID)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	accessor, err := accessorFromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	if accessor.Expired() {
+		c.logger.Debug("Secret ID expired", "accessor", accessorID)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = v1alpha1.AppRoleSecretIDObservation{
+		CreationTime:    toTime(accessor.CreationTime),
+		ExpirationTime:  toTime(accessor.ExpirationTime),
+		SecretIDNumUses: accessor.SecretIDNumUses,
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create generates a new secret ID and records its accessor as the external
+// name. The secret ID is only returned once, so it is published here along
+// with the role ID.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AppRoleSecretID)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAppRoleSecretID)
+	}
+
+	data, err := encode(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errEncode)
+	}
+
+	// the role ID is read first, as nothing must fail once the secret ID,
+	// which vault only returns once, is generated
+	roleID, err := c.readRoleID(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errReadID)
+	}
+
+	secret, err := c.client.Logical().Write(rolePath(cr.Spec.ForProvider)+"/secret-id", data)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerate)
+	}
+	if secret == nil {
+		return managed.ExternalCreation{}, errors.New(errNoSecretID)
+	}
+
+	secretID, err := secretIDFromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerate)
+	}
+	if secretID.SecretIDAccessor == "" {
+		return managed.ExternalCreation{}, errors.New(errNoSecretID)
+	}
+
+	meta.SetExternalName(cr, secretID.SecretIDAccessor)
+
+	details := managed.ConnectionDetails{
+		ConnectionKeySecretID:         []byte(secretID.SecretID),
+		ConnectionKeySecretIDAccessor: []byte(secretID.SecretIDAccessor),
+		ConnectionKeyRoleID:           []byte(roleID),
+	}
+
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    details,
+	}, nil
+}
+
+// Update does nothing as secret IDs cannot be changed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, ok := mg.(*v1alpha1.AppRoleSecretID)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAppRoleSecretID)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete destroys the secret ID by its accessor
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AppRoleSecretID)
+	if !ok {
+		return errors.New(errNotAppRoleSecretID)
+	}
+
+	accessorID := meta.GetExternalName(cr)
+	if accessorID == "" {
+		return nil
+	}
+
+	c.logger.Debug("Destroying secret ID", "accessor", accessorID)
+	_, err := c.client.Logical().Write(accessorPath(cr.Spec.ForProvider, "destroy"), map[string]interface{}{"secret_id_accessor": accessorID})
+	if err != nil && !isGone(err) {
+		return errors.Wrap(err, errDestroy)
+	}
+
+	return nil
+}
+
+func (c *external) readRoleID(params v1alpha1.AppRoleSecretIDParameters) (string, error) {
+	secret, err := c.client.Logical().Read(rolePath(params) + "/role-id")
+	if err != nil {
+		return "", err
+	}
+	if secret == nil {
+		return "", errors.New(errNoRoleID)
+	}
+	return roleIDFromVault(secret.Data)
+}
+
+// isGone tells whether vault rejected a request on a secret ID accessor, or
+// its role, because it does not know it
+func isGone(err error) bool {
+	var respErr *vault.ResponseError
+	return errors.As(err, &respErr) && (respErr.StatusCode == http.StatusBadRequest || respErr.StatusCode == http.StatusNotFound)
+}
+
+func toTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+func rolePath(params v1alpha1.AppRoleSecretIDParameters) string {
+	backend := pointer.StringDeref(params.Backend, defaultBackend)
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + params.RoleName
+}
+
+func accessorPath(params v1alpha1.AppRoleSecretIDParameters, op string) string {
+	return rolePath(params) + "/secret-id-accessor/" + op
+}
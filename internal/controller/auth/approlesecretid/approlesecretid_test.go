@@ -0,0 +1,471 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approlesecretid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testAccessor = "84896a0c-1347-aa90-a4f6-aca8b7558780"
+	testSecretID = "841771dc-11c9-bbc7-bcac-6a3945a69cd9"
+	testRoleID   = "c5c9a4f1-1e0b-4a5e-a1a4-2c5b59c6c3d8"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o      managed.ExternalObservation
+		status v1alpha1.AppRoleSecretIDObservation
+		err    error
+	}
+
+	created := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	lookup := map[string]interface{}{"secret_id_accessor": testAccessor}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not generated yet": {
+			reason: "secret ID without an accessor must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"unknown accessor": {
+			reason: "secret ID vault does not know must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(accessorPath(getTestSecretID(testAccessor).Spec.ForProvider, "lookup"), lookup).
+						Return(nil, &api.ResponseError{StatusCode: http.StatusBadRequest})
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"expired": {
+			reason: "expired secret ID must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), lookup).Return(&api.Secret{
+						Data: map[string]interface{}{
+							"creation_time":      created.Format(time.RFC3339),
+							"expiration_time":    created.Add(time.Hour).Format(time.RFC3339),
+							"secret_id_num_uses": json.Number("0"),
+						},
+					}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"error looking up": {
+			reason: "unexpected error looking up the secret ID",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), lookup).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(vaultMockError(), errLookup),
+			},
+		},
+		"exists": {
+			reason: "secret ID exists and reports its lifetime",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), lookup).Return(&api.Secret{
+						Data: map[string]interface{}{
+							"creation_time":      created.Format(time.RFC3339),
+							"expiration_time":    expires.Format(time.RFC3339),
+							"secret_id_num_uses": json.Number("10"),
+						},
+					}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				status: v1alpha1.AppRoleSecretIDObservation{
+					CreationTime:    &metav1.Time{Time: created},
+					ExpirationTime:  &metav1.Time{Time: expires},
+					SecretIDNumUses: 10,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			status := tc.args.mg.(*v1alpha1.AppRoleSecretID).Status.AtProvider
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	rolePathFor := rolePath(getTestSecretID("").Spec.ForProvider)
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully generate": {
+			reason: "secret ID must be generated and published with the role ID",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"metadata":  `{"pipeline":"deploy"}`,
+						"cidr_list": []string{"10.0.0.0/8"},
+						"ttl":       3600,
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(rolePathFor+"/role-id").Return(&api.Secret{
+						Data: map[string]interface{}{"role_id": testRoleID},
+					}, nil)
+					logicalMock.EXPECT().Write(rolePathFor+"/secret-id", data).Return(&api.Secret{
+						Data: map[string]interface{}{
+							"secret_id":          testSecretID,
+							"secret_id_accessor": testAccessor,
+						},
+					}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionKeySecretID:         []byte(testSecretID),
+						ConnectionKeySecretIDAccessor: []byte(testAccessor),
+						ConnectionKeyRoleID:           []byte(testRoleID),
+					},
+				},
+				externalName: testAccessor,
+			},
+		},
+		"missing role": {
+			reason: "no secret ID must be generated for a role that does not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(rolePathFor+"/role-id").Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(""),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errors.New(errNoRoleID), errReadID),
+			},
+		},
+		"fail generating": {
+			reason: "vault rejects the secret ID",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(gomock.Any()).Return(&api.Secret{
+						Data: map[string]interface{}{"role_id": testRoleID},
+					}, nil)
+					logicalMock.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(""),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(vaultMockError(), errGenerate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	destroy := map[string]interface{}{"secret_id_accessor": testAccessor}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully destroy": {
+			reason: "secret ID must be destroyed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(accessorPath(getTestSecretID(testAccessor).Spec.ForProvider, "destroy"), destroy).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{},
+		},
+		"already gone": {
+			reason: "secret ID vault does not know is already deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), destroy).Return(nil, &api.ResponseError{StatusCode: http.StatusNotFound})
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{},
+		},
+		"error destroying": {
+			reason: "unexpected error destroying a secret ID",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), destroy).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecretID(testAccessor),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDestroy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestSecretID(accessor string) *v1alpha1.AppRoleSecretID {
+	secretID := &v1alpha1.AppRoleSecretID{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.AppRoleSecretIDKind,
+			APIVersion: v1alpha1.AppRoleSecretIDKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ci-deploy",
+		},
+		Spec: v1alpha1.AppRoleSecretIDSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				DeletionPolicy: "Delete",
+			},
+			ForProvider: v1alpha1.AppRoleSecretIDParameters{
+				RoleName: "ci",
+				Metadata: map[string]string{"pipeline": "deploy"},
+				CIDRList: []string{"10.0.0.0/8"},
+				TTL:      pointer.Int(3600),
+			},
+		},
+	}
+	if accessor != "" {
+		meta.SetExternalName(secretID, accessor)
+	}
+	return secretID
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
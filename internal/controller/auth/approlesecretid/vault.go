@@ -0,0 +1,90 @@
+package approlesecretid
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	errDecode = "error decoding secret ID returned by vault"
+)
+
+// VaultSecretID is the secret ID generated by Vault
+type VaultSecretID struct {
+	SecretID         string `json:"secret_id"`
+	SecretIDAccessor string `json:"secret_id_accessor"`
+}
+
+// VaultSecretIDAccessor is the response of the secret-id-accessor/lookup endpoint
+type VaultSecretIDAccessor struct {
+	CreationTime    time.Time `json:"creation_time"`
+	ExpirationTime  time.Time `json:"expiration_time"`
+	SecretIDNumUses int       `json:"secret_id_num_uses"`
+}
+
+// Expired tells whether the secret ID has an expiration time and it passed
+func (a *VaultSecretIDAccessor) Expired() bool {
+	return !a.ExpirationTime.IsZero() && time.Now().After(a.ExpirationTime)
+}
+
+// VaultRoleID is the identifier of the AppRole the secret ID belongs to
+type VaultRoleID struct {
+	RoleID string `json:"role_id"`
+}
+
+func secretIDFromVault(data map[string]interface{}) (*VaultSecretID, error) {
+	secretID := &VaultSecretID{}
+	if err := clients.DecodeData(data, secretID); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return secretID, nil
+}
+
+func accessorFromVault(data map[string]interface{}) (*VaultSecretIDAccessor, error) {
+	accessor := &VaultSecretIDAccessor{}
+	if err := clients.DecodeData(data, accessor); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return accessor, nil
+}
+
+func roleIDFromVault(data map[string]interface{}) (string, error) {
+	id := &VaultRoleID{}
+	if err := clients.DecodeData(data, id); err != nil {
+		return "", errors.Wrap(err, errDecode)
+	}
+	return id.RoleID, nil
+}
+
+// encode prepares the data to generate a secret ID. Vault expects the
+// metadata as a JSON encoded string.
+func encode(params v1alpha1.AppRoleSecretIDParameters) (map[string]interface{}, error) {
+	data := map[string]interface{}{}
+
+	if params.Metadata != nil {
+		metadata, err := json.Marshal(params.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		data["metadata"] = string(metadata)
+	}
+	if params.CIDRList != nil {
+		data["cidr_list"] = params.CIDRList
+	}
+	if params.TokenBoundCIDRs != nil {
+		data["token_bound_cidrs"] = params.TokenBoundCIDRs
+	}
+	if params.TTL != nil {
+		data["ttl"] = *params.TTL
+	}
+	if params.NumUses != nil {
+		data["num_uses"] = *params.NumUses
+	}
+
+	return data, nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsclientconfig
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotAWSClientConfig = "managed resource is not an AWSClientConfig custom resource"
+	errNewExternalClient  = "cannot create vault client from config"
+
+	errRead      = "cannot read AWS auth client config"
+	errWrite     = "cannot write AWS auth client config"
+	errDelete    = "cannot delete AWS auth client config"
+	errDecode    = "error decoding AWS auth client config returned by vault"
+	errAccessKey = "cannot get AWS access key"
+	errSecretKey = "cannot get AWS secret key"
+
+	defaultBackend = "aws"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles AWSClientConfig managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AWSClientConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AWSClientConfigGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AWSClientConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AWSClientConfig)
+	if !ok {
+		return nil, errors.New(errNotAWSClientConfig)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the AWS keys from the referenced Secrets
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the client configuration of the backend, comparing only the
+// parameters set in the managed resource. Vault does not return the secret
+// key, so the hash of the AWS keys in the Secrets is compared with the hash of
+// the last ones written instead.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AWSClientConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAWSClientConfig)
+	}
+
+	secret, err := c.client.Logical().Read(configPath(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// vault returns no data while the client is not configured
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// the keys are only needed to write the configuration, not to delete
+	// it, and their Secret is often deleted along with it
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	accessKey, secretKey, err := c.keys(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := cr.Status.AtProvider.KeysHash == clients.SecretHash(c.client.Token(), cr, accessKey, secretKey) &&
+		cmp.Equal(*fromCrossplane(cr.Spec.ForProvider), *vaultData,
+			cmpopts.EquateEmpty(),
+			clients.IgnoreUnset(cr.Spec.ForProvider, VaultAWSClientConfig{}))
+
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the client configuration of the backend
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AWSClientConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAWSClientConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the client configuration of the backend again, along with
+// the keys of the referenced Secrets, whose hash is then recorded.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AWSClientConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAWSClientConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete removes the client configuration, so that vault falls back to the
+// credentials of its environment.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AWSClientConfig)
+	if !ok {
+		return errors.New(errNotAWSClientConfig)
+	}
+
+	c.logger.Debug("Deleting AWS auth client config", "path", configPath(cr.Spec.ForProvider))
+	if _, err := c.client.Logical().Delete(configPath(cr.Spec.ForProvider)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeConfig(ctx context.Context, cr *v1alpha1.AWSClientConfig) error {
+	params := cr.Spec.ForProvider
+
+	accessKey, secretKey, err := c.keys(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Writing AWS auth client config", "path", configPath(params))
+	if _, err := c.client.Logical().Write(configPath(params), encode(params, accessKey, secretKey)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	cr.Status.AtProvider.KeysHash = clients.SecretHash(c.client.Token(), cr, accessKey, secretKey)
+	return nil
+}
+
+// keys reads the AWS keys from the referenced Secrets, leaving nil the ones
+// whose reference is unset
+func (c *external) keys(ctx context.Context, params v1alpha1.AWSClientConfigParameters) ([]byte, []byte, error) {
+	var accessKey, secretKey []byte
+	if ref := params.AccessKeySecretRef; ref != nil {
+		s, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errAccessKey)
+		}
+		accessKey = s
+	}
+	if ref := params.SecretKeySecretRef; ref != nil {
+		s, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errSecretKey)
+		}
+		secretKey = s
+	}
+	return accessKey, secretKey, nil
+}
+
+func configPath(params v1alpha1.AWSClientConfigParameters) string {
+	return "auth/" + strings.Trim(pointer.StringDeref(params.Backend, defaultBackend), "/") + "/config/client"
+}
@@ -0,0 +1,551 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsclientconfig
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testConfigPath = "auth/aws/config/client"
+	testAccessKey  = "AKIAEXAMPLE"
+	testSecretKey  = "s3cr3t"
+	testToken      = "s.provider-token"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not configured": {
+			reason: "vault returns no data for a backend that was never configured",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"error reading": {
+			reason: "backend config could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"up to date with vault defaults": {
+			reason: "parameters left unset and the access key, which comes from a Secret, must not be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["access_key"] = "AKIAOTHER"
+					data["endpoint"] = "https://ec2.us-east-1.amazonaws.com"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: keysSecret(testAccessKey, testSecretKey),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"outdated": {
+			reason: "a parameter set in the managed resource differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["iam_server_id_header_value"] = "vault.other.example.com"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: keysSecret(testAccessKey, testSecretKey),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"keys changed": {
+			reason: "new keys in the Secrets must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: keysSecret(testAccessKey, "rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"keys not recorded": {
+			reason: "a client config whose keys hash was never recorded must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: keysSecret(testAccessKey, testSecretKey),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail reading access key": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testKeysHash(testAccessKey, testSecretKey)),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errAccessKey),
+			},
+		},
+		"deleted": {
+			reason: "a config being deleted must not read the keys, which may be gone already",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.AWSClientConfig {
+					cr := getTestConfig(testKeysHash(testAccessKey, testSecretKey))
+					now := metav1.Now()
+					cr.SetDeletionTimestamp(&now)
+					return cr
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalCreation
+		hash string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "client config must be written with the keys read from the referenced Secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"access_key":                 "AKIAEXAMPLE",
+						"secret_key":                 "s3cr3t",
+						"sts_endpoint":               "https://sts.us-east-1.amazonaws.com",
+						"sts_region":                 "us-east-1",
+						"iam_server_id_header_value": "vault.example.com",
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				kube: keysSecret(testAccessKey, testSecretKey),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				hash: testKeysHash(testAccessKey, testSecretKey),
+			},
+		},
+		"fail reading access key": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errAccessKey),
+			},
+		},
+		"fail writing": {
+			reason: "vault rejects the client config",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			hash := tc.args.mg.(*v1alpha1.AWSClientConfig).Status.AtProvider.KeysHash
+			if diff := cmp.Diff(tc.want.hash, hash); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want hash, +got hash:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully delete": {
+			reason: "client config must be deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testConfigPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{},
+		},
+		"error deleting": {
+			reason: "unexpected error deleting the client config",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func getTestConfig(hash string) *v1alpha1.AWSClientConfig {
+	return &v1alpha1.AWSClientConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.AWSClientConfigKind,
+			APIVersion: v1alpha1.AWSClientConfigKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "aws",
+		},
+		Spec: v1alpha1.AWSClientConfigSpec{
+			ForProvider: v1alpha1.AWSClientConfigParameters{
+				AccessKeySecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "vault-aws", Namespace: "crossplane-system"},
+					Key:             "access_key_id",
+				},
+				SecretKeySecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "vault-aws", Namespace: "crossplane-system"},
+					Key:             "secret_access_key",
+				},
+				STSEndpoint:            pointer.String("https://sts.us-east-1.amazonaws.com"),
+				STSRegion:              pointer.String("us-east-1"),
+				IAMServerIDHeaderValue: pointer.String("vault.example.com"),
+			},
+		},
+		Status: v1alpha1.AWSClientConfigStatus{
+			AtProvider: v1alpha1.AWSClientConfigObservation{KeysHash: hash},
+		},
+	}
+}
+
+func keysSecret(accessKey, secretKey string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "vault-aws" || key.Namespace != "crossplane-system" {
+				return errors.New("unexpected secret")
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{
+				"access_key_id":     []byte(accessKey),
+				"secret_access_key": []byte(secretKey),
+			}
+			return nil
+		},
+	}
+}
+
+func testKeysHash(accessKey, secretKey string) string {
+	return clients.SecretHash(testToken, &v1alpha1.AWSClientConfig{}, []byte(accessKey), []byte(secretKey))
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"access_key":                 "AKIAEXAMPLE",
+		"endpoint":                   "",
+		"iam_endpoint":               "",
+		"sts_endpoint":               "https://sts.us-east-1.amazonaws.com",
+		"sts_region":                 "us-east-1",
+		"iam_server_id_header_value": "vault.example.com",
+		"max_retries":                json.Number("-1"),
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+	clientMock.EXPECT().Token().Return(testToken).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
@@ -0,0 +1,71 @@
+package awsclientconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultAWSClientConfig is an helper struct to compare the client
+// configuration of the crossplane resource with the one vault holds. The AWS
+// keys are not part of it as vault never returns the secret key.
+type VaultAWSClientConfig struct {
+	Endpoint               string `json:"endpoint"`
+	IAMEndpoint            string `json:"iam_endpoint"`
+	STSEndpoint            string `json:"sts_endpoint"`
+	STSRegion              string `json:"sts_region"`
+	IAMServerIDHeaderValue string `json:"iam_server_id_header_value"`
+	MaxRetries             int    `json:"max_retries"`
+}
+
+func fromCrossplane(params v1alpha1.AWSClientConfigParameters) *VaultAWSClientConfig {
+	return &VaultAWSClientConfig{
+		Endpoint:               pointer.StringDeref(params.Endpoint, ""),
+		IAMEndpoint:            pointer.StringDeref(params.IAMEndpoint, ""),
+		STSEndpoint:            pointer.StringDeref(params.STSEndpoint, ""),
+		STSRegion:              pointer.StringDeref(params.STSRegion, ""),
+		IAMServerIDHeaderValue: pointer.StringDeref(params.IAMServerIDHeaderValue, ""),
+		MaxRetries:             pointer.IntDeref(params.MaxRetries, 0),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAWSClientConfig, error) {
+	config := &VaultAWSClientConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the client configuration. The keys
+// are only sent when their secret references are set, so that the backend
+// can fall back to the AWS credentials of its environment.
+func encode(params v1alpha1.AWSClientConfigParameters, accessKey, secretKey []byte) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	setString(data, "endpoint", params.Endpoint)
+	setString(data, "iam_endpoint", params.IAMEndpoint)
+	setString(data, "sts_endpoint", params.STSEndpoint)
+	setString(data, "sts_region", params.STSRegion)
+	setString(data, "iam_server_id_header_value", params.IAMServerIDHeaderValue)
+
+	if params.MaxRetries != nil {
+		data["max_retries"] = *params.MaxRetries
+	}
+	if accessKey != nil {
+		data["access_key"] = string(accessKey)
+	}
+	if secretKey != nil {
+		data["secret_key"] = string(secretKey)
+	}
+
+	return data
+}
+
+func setString(data map[string]interface{}, key string, value *string) {
+	if value != nil {
+		data[key] = *value
+	}
+}
@@ -0,0 +1,123 @@
+package awsrole
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultAWSRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultAWSRole struct {
+	AuthType                   string   `json:"auth_type"`
+	BoundIAMPrincipalARN       []string `json:"bound_iam_principal_arn"`
+	InferredEntityType         string   `json:"inferred_entity_type"`
+	InferredAWSRegion          string   `json:"inferred_aws_region"`
+	ResolveAWSUniqueIDs        bool     `json:"resolve_aws_unique_ids"`
+	BoundAMIID                 []string `json:"bound_ami_id"`
+	BoundAccountID             []string `json:"bound_account_id"`
+	BoundRegion                []string `json:"bound_region"`
+	BoundVPCID                 []string `json:"bound_vpc_id"`
+	BoundSubnetID              []string `json:"bound_subnet_id"`
+	BoundIAMRoleARN            []string `json:"bound_iam_role_arn"`
+	BoundIAMInstanceProfileARN []string `json:"bound_iam_instance_profile_arn"`
+	BoundEC2InstanceID         []string `json:"bound_ec2_instance_id"`
+	RoleTag                    string   `json:"role_tag"`
+	AllowInstanceMigration     bool     `json:"allow_instance_migration"`
+	DisallowReauthentication   bool     `json:"disallow_reauthentication"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.AWSRoleParameters) *VaultAWSRole {
+	return &VaultAWSRole{
+		AuthType:                   pointer.StringDeref(params.AuthType, ""),
+		BoundIAMPrincipalARN:       params.BoundIAMPrincipalARN,
+		InferredEntityType:         pointer.StringDeref(params.InferredEntityType, ""),
+		InferredAWSRegion:          pointer.StringDeref(params.InferredAWSRegion, ""),
+		ResolveAWSUniqueIDs:        pointer.BoolDeref(params.ResolveAWSUniqueIDs, false),
+		BoundAMIID:                 params.BoundAMIID,
+		BoundAccountID:             params.BoundAccountID,
+		BoundRegion:                params.BoundRegion,
+		BoundVPCID:                 params.BoundVPCID,
+		BoundSubnetID:              params.BoundSubnetID,
+		BoundIAMRoleARN:            params.BoundIAMRoleARN,
+		BoundIAMInstanceProfileARN: params.BoundIAMInstanceProfileARN,
+		BoundEC2InstanceID:         params.BoundEC2InstanceID,
+		RoleTag:                    pointer.StringDeref(params.RoleTag, ""),
+		AllowInstanceMigration:     pointer.BoolDeref(params.AllowInstanceMigration, false),
+		DisallowReauthentication:   pointer.BoolDeref(params.DisallowReauthentication, false),
+		VaultTokenFields:           tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAWSRole, error) {
+	role := &VaultAWSRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role. Only the bound lists set in
+// the managed resource are sent, since vault rejects the EC2 bounds on an
+// iam role that does not infer an EC2 instance.
+func encode(params v1alpha1.AWSRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	setString(data, "auth_type", params.AuthType)
+	setString(data, "inferred_entity_type", params.InferredEntityType)
+	setString(data, "inferred_aws_region", params.InferredAWSRegion)
+	setString(data, "role_tag", params.RoleTag)
+
+	setBool(data, "resolve_aws_unique_ids", params.ResolveAWSUniqueIDs)
+	setBool(data, "allow_instance_migration", params.AllowInstanceMigration)
+	setBool(data, "disallow_reauthentication", params.DisallowReauthentication)
+
+	setStrings(data, "bound_iam_principal_arn", params.BoundIAMPrincipalARN)
+	setStrings(data, "bound_ami_id", params.BoundAMIID)
+	setStrings(data, "bound_account_id", params.BoundAccountID)
+	setStrings(data, "bound_region", params.BoundRegion)
+	setStrings(data, "bound_vpc_id", params.BoundVPCID)
+	setStrings(data, "bound_subnet_id", params.BoundSubnetID)
+	setStrings(data, "bound_iam_role_arn", params.BoundIAMRoleARN)
+	setStrings(data, "bound_iam_instance_profile_arn", params.BoundIAMInstanceProfileARN)
+	setStrings(data, "bound_ec2_instance_id", params.BoundEC2InstanceID)
+
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.AWSRoleParameters, vaultData *VaultAWSRole) bool {
+	li := false
+
+	li = clients.LateInitString(&params.AuthType, vaultData.AuthType) || li
+	li = clients.LateInitBool(&params.ResolveAWSUniqueIDs, vaultData.ResolveAWSUniqueIDs) || li
+	li = tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields) || li
+
+	return li
+}
+
+func setString(data map[string]interface{}, key string, value *string) {
+	if value != nil {
+		data[key] = *value
+	}
+}
+
+func setBool(data map[string]interface{}, key string, value *bool) {
+	if value != nil {
+		data[key] = *value
+	}
+}
+
+func setStrings(data map[string]interface{}, key string, value []string) {
+	if value != nil {
+		data[key] = value
+	}
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsstsrole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const testPath = "auth/aws/config/sts/210987654321"
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "AWS STS role must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"error reading": {
+			reason: "AWS STS role could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testPath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"exists and is up to date": {
+			reason: "AWS STS role exists with the same parameters",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"outdated": {
+			reason: "the account is trusted through another role in vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["sts_role"] = "arn:aws:iam::210987654321:role/legacy-vault-auth"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "AWS STS role must be created",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"sts_role":    "arn:aws:iam::210987654321:role/vault-auth",
+						"external_id": "vault",
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail creating": {
+			reason: "vault rejects the AWS STS role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(vaultMockError(), errCreation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully delete": {
+			reason: "AWS STS role must be deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{},
+		},
+		"error deleting": {
+			reason: "unexpected error deleting an AWS STS role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestRole() *v1alpha1.AWSSTSRole {
+	role := &v1alpha1.AWSSTSRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.AWSSTSRoleKind,
+			APIVersion: v1alpha1.AWSSTSRoleKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "staging",
+		},
+		Spec: v1alpha1.AWSSTSRoleSpec{
+			ForProvider: v1alpha1.AWSSTSRoleParameters{
+				STSRole:    "arn:aws:iam::210987654321:role/vault-auth",
+				ExternalID: pointer.String("vault"),
+			},
+		},
+	}
+	meta.SetExternalName(role, "210987654321")
+	return role
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"sts_role":    "arn:aws:iam::210987654321:role/vault-auth",
+		"external_id": "vault",
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
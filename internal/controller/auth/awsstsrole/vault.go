@@ -0,0 +1,43 @@
+package awsstsrole
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultAWSSTSRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultAWSSTSRole struct {
+	STSRole    string `json:"sts_role"`
+	ExternalID string `json:"external_id"`
+}
+
+func fromCrossplane(params v1alpha1.AWSSTSRoleParameters) *VaultAWSSTSRole {
+	return &VaultAWSSTSRole{
+		STSRole:    params.STSRole,
+		ExternalID: pointer.StringDeref(params.ExternalID, ""),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAWSSTSRole, error) {
+	role := &VaultAWSSTSRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.AWSSTSRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"sts_role": params.STSRole,
+	}
+
+	if params.ExternalID != nil {
+		data["external_id"] = *params.ExternalID
+	}
+
+	return data
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurebackendconfig
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotAzureBackendConfig = "managed resource is not an AzureBackendConfig custom resource"
+	errNewExternalClient     = "cannot create vault client from config"
+
+	errRead         = "cannot read Azure auth backend config"
+	errWrite        = "cannot write Azure auth backend config"
+	errDelete       = "cannot delete Azure auth backend config"
+	errDecode       = "error decoding Azure auth backend config returned by vault"
+	errClientSecret = "cannot get Azure client secret"
+
+	defaultBackend = "azure"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles AzureBackendConfig managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AzureBackendConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AzureBackendConfigGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.AzureBackendConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AzureBackendConfig)
+	if !ok {
+		return nil, errors.New(errNotAzureBackendConfig)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the client secret from the referenced Secret
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the configuration of the backend, comparing only the
+// parameters set in the managed resource. Vault does not return the client
+// secret, so the hash of the one in the Secret is compared with the hash of
+// the last one written instead.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AzureBackendConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAzureBackendConfig)
+	}
+
+	secret, err := c.client.Logical().Read(configPath(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// vault returns no data while a backend is not configured
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// deleting the configuration does not send the client secret, so its
+	// Secret is not read, in case it was deleted first
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	clientSecret, err := c.clientSecret(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := cr.Status.AtProvider.ClientSecretHash == clients.SecretHash(c.client.Token(), cr, clientSecret) &&
+		cmp.Equal(*fromCrossplane(cr.Spec.ForProvider), *vaultData,
+			cmpopts.EquateEmpty(),
+			clients.IgnoreUnset(cr.Spec.ForProvider, VaultAzureBackendConfig{}))
+
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the configuration of the backend
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AzureBackendConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAzureBackendConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the configuration of the backend again, along with the
+// client secret of the referenced Secret, whose hash is then recorded.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AzureBackendConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAzureBackendConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete removes the configuration of the backend.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AzureBackendConfig)
+	if !ok {
+		return errors.New(errNotAzureBackendConfig)
+	}
+
+	c.logger.Debug("Deleting Azure auth backend config", "path", configPath(cr.Spec.ForProvider))
+	if _, err := c.client.Logical().Delete(configPath(cr.Spec.ForProvider)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeConfig(ctx context.Context, cr *v1alpha1.AzureBackendConfig) error {
+	params := cr.Spec.ForProvider
+
+	clientSecret, err := c.clientSecret(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Writing Azure auth backend config", "path", configPath(params))
+	if _, err := c.client.Logical().Write(configPath(params), encode(params, clientSecret)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	cr.Status.AtProvider.ClientSecretHash = clients.SecretHash(c.client.Token(), cr, clientSecret)
+	return nil
+}
+
+// clientSecret reads the client secret from the referenced Secret, leaving it
+// nil when the reference is unset
+func (c *external) clientSecret(ctx context.Context, params v1alpha1.AzureBackendConfigParameters) ([]byte, error) {
+	ref := params.ClientSecretSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+	s, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+	if err != nil {
+		return nil, errors.Wrap(err, errClientSecret)
+	}
+	return s, nil
+}
+
+func configPath(params v1alpha1.AzureBackendConfigParameters) string {
+	return "auth/" + strings.Trim(pointer.StringDeref(params.Backend, defaultBackend), "/") + "/config"
+}
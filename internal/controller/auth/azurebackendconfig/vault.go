@@ -0,0 +1,58 @@
+package azurebackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultAzureBackendConfig is an helper struct to compare the configuration
+// of the crossplane resource with the one vault holds. The client secret is
+// not part of it as vault never returns it.
+type VaultAzureBackendConfig struct {
+	TenantID    string `json:"tenant_id"`
+	Resource    string `json:"resource"`
+	Environment string `json:"environment"`
+	ClientID    string `json:"client_id"`
+}
+
+func fromCrossplane(params v1alpha1.AzureBackendConfigParameters) *VaultAzureBackendConfig {
+	return &VaultAzureBackendConfig{
+		TenantID:    params.TenantID,
+		Resource:    params.Resource,
+		Environment: pointer.StringDeref(params.Environment, ""),
+		ClientID:    pointer.StringDeref(params.ClientID, ""),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAzureBackendConfig, error) {
+	config := &VaultAzureBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The
+// tenant and resource are always sent; the client secret only when its
+// secret reference is set, so that vault can use managed identities instead.
+func encode(params v1alpha1.AzureBackendConfigParameters, clientSecret []byte) map[string]interface{} {
+	data := map[string]interface{}{
+		"tenant_id": params.TenantID,
+		"resource":  params.Resource,
+	}
+
+	if params.Environment != nil {
+		data["environment"] = *params.Environment
+	}
+	if params.ClientID != nil {
+		data["client_id"] = *params.ClientID
+	}
+	if clientSecret != nil {
+		data["client_secret"] = string(clientSecret)
+	}
+
+	return data
+}
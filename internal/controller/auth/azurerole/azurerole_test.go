@@ -0,0 +1,454 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurerole
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const testRolePath = "auth/azure/role/ci-deploy"
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalObservation
+		role *v1alpha1.AzureRole
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "Azure role must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				role: getTestRole(),
+			},
+		},
+		"error reading": {
+			reason: "Azure role could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err:  errors.Wrap(vaultMockError(), errRead),
+				role: getTestRole(),
+			},
+		},
+		"up to date and late initialized": {
+			reason: "vault defaults must be late initialized and not reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				role: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.TokenType = pointer.String("default")
+					return role
+				}(),
+			},
+		},
+		"outdated": {
+			reason: "a token field set in the managed resource differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["token_policies"] = []interface{}{"default"}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.TokenType = pointer.String("default")
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				role: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.TokenType = pointer.String("default")
+					return role
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.role.Spec, tc.args.mg.(*v1alpha1.AzureRole).Spec); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want spec, +got spec:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "only the parameters set must be sent to vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"bound_subscription_ids": []string{"00000000-0000-0000-0000-000000000003"},
+						"bound_resource_groups":  []string{"ci"},
+						"token_ttl":              3600,
+						"token_policies":         []string{"ci-deploy"},
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testRolePath, data).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail validating": {
+			reason: "a token TTL greater than the max TTL must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.TokenMaxTTL = pointer.Int(60)
+					return role
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("token_ttl cannot be greater than token_max_ttl"), errCreation),
+			},
+		},
+		"fail validating token type": {
+			reason: "token types meant for token roles must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.TokenType = pointer.String("default-batch")
+					return role
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("token_type default-service and default-batch are only valid for token roles"), errCreation),
+			},
+		},
+		"fail validating bounds": {
+			reason: "a role bound to no identities must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.AzureRole {
+					role := getTestRole()
+					role.Spec.ForProvider.BoundSubscriptionIDs = nil
+					role.Spec.ForProvider.BoundResourceGroups = nil
+					return role
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("at least one of: `bound_service_principal_ids`, `bound_group_ids`, `bound_locations`, `bound_subscription_ids`, `bound_resource_groups` or `bound_scale_sets` must be set"), errCreation),
+			},
+		},
+		"fail creating": {
+			reason: "vault rejects the Azure role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testRolePath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errCreation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		want   want
+	}{
+		"successfully delete": {
+			reason: "Azure role must be deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testRolePath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+		},
+		"error deleting": {
+			reason: "unexpected error deleting an Azure role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testRolePath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(context.TODO(), getTestRole())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestRole() *v1alpha1.AzureRole {
+	role := &v1alpha1.AzureRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.AzureRoleKind,
+			APIVersion: v1alpha1.AzureRoleKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ci-deploy",
+		},
+		Spec: v1alpha1.AzureRoleSpec{
+			ForProvider: v1alpha1.AzureRoleParameters{
+				BoundSubscriptionIDs: []string{"00000000-0000-0000-0000-000000000003"},
+				BoundResourceGroups:  []string{"ci"},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenTTL:      pointer.Int(3600),
+					TokenPolicies: []string{"ci-deploy"},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(role, "ci-deploy")
+	return role
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"bound_service_principal_ids": []interface{}{},
+		"bound_group_ids":             []interface{}{},
+		"bound_locations":             []interface{}{},
+		"bound_subscription_ids":      []interface{}{"00000000-0000-0000-0000-000000000003"},
+		"bound_resource_groups":       []interface{}{"ci"},
+		"bound_scale_sets":            []interface{}{},
+		"token_ttl":                   json.Number("3600"),
+		"token_max_ttl":               json.Number("0"),
+		"token_policies":              []interface{}{"ci-deploy"},
+		"token_bound_cidrs":           []interface{}{},
+		"token_explicit_max_ttl":      json.Number("0"),
+		"token_no_default_policy":     false,
+		"token_num_uses":              json.Number("0"),
+		"token_period":                json.Number("0"),
+		"token_type":                  "default",
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
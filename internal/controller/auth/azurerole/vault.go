@@ -0,0 +1,70 @@
+package azurerole
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultAzureRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultAzureRole struct {
+	BoundServicePrincipalIDs []string `json:"bound_service_principal_ids"`
+	BoundGroupIDs            []string `json:"bound_group_ids"`
+	BoundLocations           []string `json:"bound_locations"`
+	BoundSubscriptionIDs     []string `json:"bound_subscription_ids"`
+	BoundResourceGroups      []string `json:"bound_resource_groups"`
+	BoundScaleSets           []string `json:"bound_scale_sets"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.AzureRoleParameters) *VaultAzureRole {
+	return &VaultAzureRole{
+		BoundServicePrincipalIDs: params.BoundServicePrincipalIDs,
+		BoundGroupIDs:            params.BoundGroupIDs,
+		BoundLocations:           params.BoundLocations,
+		BoundSubscriptionIDs:     params.BoundSubscriptionIDs,
+		BoundResourceGroups:      params.BoundResourceGroups,
+		BoundScaleSets:           params.BoundScaleSets,
+		VaultTokenFields:         tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultAzureRole, error) {
+	role := &VaultAzureRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role from the bound lists and
+// the token parameters set in the managed resource.
+func encode(params v1alpha1.AzureRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	setStrings(data, "bound_service_principal_ids", params.BoundServicePrincipalIDs)
+	setStrings(data, "bound_group_ids", params.BoundGroupIDs)
+	setStrings(data, "bound_locations", params.BoundLocations)
+	setStrings(data, "bound_subscription_ids", params.BoundSubscriptionIDs)
+	setStrings(data, "bound_resource_groups", params.BoundResourceGroups)
+	setStrings(data, "bound_scale_sets", params.BoundScaleSets)
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the token parameters left unset in the managed
+// resource with the values Vault holds. It returns true when any parameter
+// was filled.
+func lateInitialize(params *v1alpha1.AzureRoleParameters, vaultData *VaultAzureRole) bool {
+	return tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields)
+}
+
+func setStrings(data map[string]interface{}, key string, value []string) {
+	if value != nil {
+		data[key] = value
+	}
+}
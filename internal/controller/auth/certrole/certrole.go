@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certrole
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCertRole       = "managed resource is not a CertRole custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errCreation  = "cannot create certificate auth role"
+	errUpdate    = "cannot update certificate auth role"
+	errDelete    = "cannot delete certificate auth role"
+	errRead      = "cannot read certificate auth role"
+	errDecode    = "error decoding certificate auth role returned by vault"
+	errCert      = "cannot get the certificate of the certificate auth role"
+	errNoCertKey = "the referenced ConfigMap has no such key"
+
+	errValidationCertificate = "exactly one of certificateSecretRef and certificateConfigMapRef must be set"
+
+	defaultBackend = "cert"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles CertRole managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CertRoleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CertRoleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			apiReader:    mgr.GetAPIReader(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.CertRole{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	apiReader    client.Reader
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CertRole)
+	if !ok {
+		return nil, errors.New(errNotCertRole)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client:    vaultClient,
+		kube:      c.kube,
+		apiReader: c.apiReader,
+		logger:    c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the certificate from the referenced Secret
+	kube client.Client
+
+	// apiReader reads the certificate from the referenced ConfigMap straight
+	// from the API server, so that no ConfigMap informer is started for the
+	// whole cluster
+	apiReader client.Reader
+
+	logger logging.Logger
+}
+
+// Observe reads the role, late initializing the parameters left unset with
+// the values vault holds and comparing only the ones set. The certificates are
+// compared by their fingerprints, so that formatting differences in the PEM
+// encoding are not reported as drift.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	role, ok := mg.(*v1alpha1.CertRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertRole)
+	}
+
+	secret, err := c.client.Logical().Read(rolePath(role))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// the ConfigMap is commonly deleted along with the role, and removing
+	// the role from vault does not need its certificate
+	if meta.WasDeleted(role) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	certificate, err := c.certificate(ctx, role.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	wantFingerprints, err := fingerprints(certificate)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCert)
+	}
+
+	// a certificate vault holds but cannot be parsed is reported as drift,
+	// so that it is replaced
+	vaultFingerprints, _ := fingerprints(vaultData.Certificate)
+	role.Status.AtProvider.CertificateFingerprints = vaultFingerprints
+
+	lateInitialized := lateInitialize(&role.Spec.ForProvider, vaultData)
+	upToDate := cmp.Equal(wantFingerprints, vaultFingerprints) &&
+		cmp.Equal(*fromCrossplane(role.Spec.ForProvider, ""), *vaultData,
+			cmpopts.EquateEmpty(),
+			cmpopts.IgnoreFields(VaultCertRole{}, "Certificate"),
+			clients.IgnoreUnset(role.Spec.ForProvider, VaultCertRole{}))
+
+	if upToDate {
+		role.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create a certificate auth role
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	role, ok := mg.(*v1alpha1.CertRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertRole)
+	}
+
+	if err := c.writeRole(ctx, role); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreation)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update a certificate auth role, reading the certificate from the
+// ConfigMap again.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	role, ok := mg.(*v1alpha1.CertRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertRole)
+	}
+
+	if err := c.writeRole(ctx, role); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete a certificate auth role
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	role, ok := mg.(*v1alpha1.CertRole)
+	if !ok {
+		return errors.New(errNotCertRole)
+	}
+
+	c.logger.Debug("Deleting certificate auth role", "path", rolePath(role))
+	if _, err := c.client.Logical().Delete(rolePath(role)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeRole(ctx context.Context, role *v1alpha1.CertRole) error {
+	if err := tokenfields.Validate(role.Spec.ForProvider.TokenParameters); err != nil {
+		return err
+	}
+
+	certificate, err := c.certificate(ctx, role.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Creating/Updating certificate auth role", "path", rolePath(role))
+	_, err = c.client.Logical().Write(rolePath(role), encode(role.Spec.ForProvider, certificate))
+	return err
+}
+
+// certificate reads the certificate of the role from the referenced Secret or
+// ConfigMap
+func (c *external) certificate(ctx context.Context, params v1alpha1.CertRoleParameters) (string, error) {
+	if (params.CertificateSecretRef == nil) == (params.CertificateConfigMapRef == nil) {
+		return "", errors.New(errValidationCertificate)
+	}
+
+	if ref := params.CertificateSecretRef; ref != nil {
+		s, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+		if err != nil {
+			return "", errors.Wrap(err, errCert)
+		}
+		return string(s), nil
+	}
+
+	ref := params.CertificateConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := c.apiReader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errCert)
+	}
+	certificate, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Wrap(errors.New(errNoCertKey), errCert)
+	}
+	return certificate, nil
+}
+
+func rolePath(role *v1alpha1.CertRole) string {
+	backend := pointer.StringDeref(role.Spec.ForProvider.Backend, defaultBackend)
+	return "auth/" + strings.Trim(backend, "/") + "/certs/" + meta.GetExternalName(role)
+}
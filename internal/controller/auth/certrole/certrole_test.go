@@ -0,0 +1,628 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certrole
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testRolePath = "auth/cert/certs/legacy-vm"
+
+	testCert = `-----BEGIN CERTIFICATE-----
+MIIBgzCCASmgAwIBAgIUHf8wrDXLyb9xBw8SOz6U2nCjnVYwCgYIKoZIzj0EAwIw
+FjEUMBIGA1UEAwwLbGVnYWN5LXZtLWEwIBcNMjYxMDE4MTYwODI4WhgPMjEyNjA5
+MjQxNjA4MjhaMBYxFDASBgNVBAMMC2xlZ2FjeS12bS1hMFkwEwYHKoZIzj0CAQYI
+KoZIzj0DAQcDQgAEORAmLXCVsVFbDmTc4vkwYBF98YSi3zHDXfLsYIW7WYkXbvTT
+WXTTXh3Fz23r/nqvfQwE7do8StDMliwwIPpMOqNTMFEwHQYDVR0OBBYEFMxTJV4y
+asMPI0bfxRpq1DK80udKMB8GA1UdIwQYMBaAFMxTJV4yasMPI0bfxRpq1DK80udK
+MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIhALbdtuASpqrTahbN
+X+1oMi5fnGsMPKx+4+4kFSO9jRvhAiBUfiyLH+vMs+kkJDh/WqXtcGsfq40g4mkY
+0N0qU05tFg==
+-----END CERTIFICATE-----
+`
+	testCertFingerprint = "71165eff7226d6fb3bc4e289ef2f3de08e70240c1346b40a112bac5ee8299219"
+
+	otherCert = `-----BEGIN CERTIFICATE-----
+MIIBgjCCASmgAwIBAgIUVaqMjRoJriKruBez9zqi7TezJjMwCgYIKoZIzj0EAwIw
+FjEUMBIGA1UEAwwLbGVnYWN5LXZtLWIwIBcNMjYxMDE4MTYwODI4WhgPMjEyNjA5
+MjQxNjA4MjhaMBYxFDASBgNVBAMMC2xlZ2FjeS12bS1iMFkwEwYHKoZIzj0CAQYI
+KoZIzj0DAQcDQgAEUb12DbKQPo5AsDLvTD/X3boZl1N8SholquSE5WJctSsW32lh
+KRp5C1bh+/HI9j9jUl9wHjv1zMokd9x/QxuiY6NTMFEwHQYDVR0OBBYEFEDccG8B
+TIREgGhiBVcY6DjYmuRwMB8GA1UdIwQYMBaAFEDccG8BTIREgGhiBVcY6DjYmuRw
+MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDRwAwRAIgYoUA17i+rFl9pfBX
+B04loi2g9YdOtl52AAEX/+DCw38CICOD9iUTo2mfIOFyJabQnwJxGs/TtS4qkyQQ
+rmJqOo03
+-----END CERTIFICATE-----
+`
+	otherCertFingerprint = "767803dcf9a7c2f72ae8900a793609fd9d80ad01700f39c22b71d259c85431ac"
+)
+
+var errBoom = errors.New("boom")
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+		apiReader     client.Reader
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o            managed.ExternalObservation
+		fingerprints []string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "certificate role must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(nil, nil)
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"error reading": {
+			reason: "certificate role could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"same certificate encoded differently": {
+			reason: "certificates must be compared by fingerprint, not by their PEM encoding",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["certificate"] = "\n" + strings.ReplaceAll(testCert, "\n", "\r\n")
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				fingerprints: []string{testCertFingerprint},
+			},
+		},
+		"certificate rotated": {
+			reason: "a new certificate in the ConfigMap must be written to vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				apiReader: certConfigMap(otherCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				fingerprints: []string{testCertFingerprint},
+			},
+		},
+		"outdated": {
+			reason: "a parameter set in the managed resource differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["allowed_common_names"] = []interface{}{"*.example.com"}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				fingerprints: []string{testCertFingerprint},
+			},
+		},
+		"fail reading certificate": {
+			reason: "the referenced ConfigMap could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCert),
+			},
+		},
+		"deleted": {
+			reason: "a role being deleted must not read the certificate, which may be gone already",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testRolePath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.CertRole {
+					role := getTestRole()
+					now := metav1.Now()
+					role.SetDeletionTimestamp(&now)
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client:    tc.fields.clientBuilder(t),
+				kube:      tc.fields.kube,
+				apiReader: tc.fields.apiReader,
+				logger:    logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			fingerprints := tc.args.mg.(*v1alpha1.CertRole).Status.AtProvider.CertificateFingerprints
+			if diff := cmp.Diff(tc.want.fingerprints, fingerprints); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want fingerprints, +got fingerprints:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+		apiReader     client.Reader
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "certificate role must be written with the certificate from the ConfigMap",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"certificate":          testCert,
+						"allowed_common_names": []string{"legacy-vm-*"},
+						"token_ttl":            3600,
+						"token_policies":       []string{"legacy-vm"},
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testRolePath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"successfully create from a Secret": {
+			reason: "certificate role must be written with the certificate from the Secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testRolePath, gomock.Any()).
+						DoAndReturn(func(_ string, data map[string]interface{}) (*api.Secret, error) {
+							if data["certificate"] != otherCert {
+								t.Errorf("unexpected certificate %v", data["certificate"])
+							}
+							return nil, nil
+						})
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if key.Name != "legacy-vm-ca" || key.Namespace != "crossplane-system" {
+							return errors.New("unexpected secret")
+						}
+						obj.(*corev1.Secret).Data = map[string][]byte{"ca.crt": []byte(otherCert)}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.CertRole {
+					role := getTestRole()
+					role.Spec.ForProvider.CertificateConfigMapRef = nil
+					role.Spec.ForProvider.CertificateSecretRef = &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "legacy-vm-ca", Namespace: "crossplane-system"},
+						Key:             "ca.crt",
+					}
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail validating": {
+			reason: "a role with both a Secret and a ConfigMap certificate must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.CertRole {
+					role := getTestRole()
+					role.Spec.ForProvider.CertificateSecretRef = &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "legacy-vm-ca", Namespace: "crossplane-system"},
+						Key:             "ca.crt",
+					}
+					return role
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errValidationCertificate), errCreation),
+			},
+		},
+		"missing key": {
+			reason: "a ConfigMap without the referenced key must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errors.New(errNoCertKey), errCert), errCreation),
+			},
+		},
+		"fail creating": {
+			reason: "vault rejects the certificate role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testRolePath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				apiReader: certConfigMap(testCert),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errCreation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client:    tc.fields.clientBuilder(t),
+				kube:      tc.fields.kube,
+				apiReader: tc.fields.apiReader,
+				logger:    logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully delete": {
+			reason: "certificate role must be deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testRolePath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{},
+		},
+		"error deleting": {
+			reason: "unexpected error deleting a certificate role",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestRole() *v1alpha1.CertRole {
+	role := &v1alpha1.CertRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.CertRoleKind,
+			APIVersion: v1alpha1.CertRoleKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "legacy-vm",
+		},
+		Spec: v1alpha1.CertRoleSpec{
+			ForProvider: v1alpha1.CertRoleParameters{
+				CertificateConfigMapRef: &v1alpha1.ConfigMapKeySelector{
+					Name:      "legacy-vm-ca",
+					Namespace: "crossplane-system",
+					Key:       "ca.crt",
+				},
+				AllowedCommonNames: []string{"legacy-vm-*"},
+				TokenParameters: v1alpha1.TokenParameters{
+					TokenTTL:      pointer.Int(3600),
+					TokenPolicies: []string{"legacy-vm"},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(role, "legacy-vm")
+	return role
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"certificate":                  testCert,
+		"display_name":                 "legacy-vm",
+		"allowed_common_names":         []interface{}{"legacy-vm-*"},
+		"allowed_dns_sans":             []interface{}{},
+		"allowed_email_sans":           []interface{}{},
+		"allowed_uri_sans":             []interface{}{},
+		"allowed_organizational_units": []interface{}{},
+		"required_extensions":          []interface{}{},
+		"ocsp_enabled":                 false,
+		"ocsp_ca_certificates":         "",
+		"ocsp_servers_override":        []interface{}{},
+		"ocsp_fail_open":               false,
+		"ocsp_query_all_servers":       false,
+		"token_ttl":                    json.Number("3600"),
+		"token_max_ttl":                json.Number("0"),
+		"token_policies":               []interface{}{"legacy-vm"},
+		"token_type":                   "default",
+	}
+}
+
+func certConfigMap(certificate string) client.Reader {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "legacy-vm-ca" || key.Namespace != "crossplane-system" {
+				return errors.New("unexpected config map")
+			}
+			obj.(*corev1.ConfigMap).Data = map[string]string{"ca.crt": certificate}
+			return nil
+		},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
@@ -0,0 +1,145 @@
+package certrole
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+const (
+	errNoCertificate = "no PEM encoded certificate found"
+)
+
+// VaultCertRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultCertRole struct {
+	Certificate                string   `json:"certificate"`
+	DisplayName                string   `json:"display_name"`
+	AllowedCommonNames         []string `json:"allowed_common_names"`
+	AllowedDNSSANs             []string `json:"allowed_dns_sans"`
+	AllowedEmailSANs           []string `json:"allowed_email_sans"`
+	AllowedURISANs             []string `json:"allowed_uri_sans"`
+	AllowedOrganizationalUnits []string `json:"allowed_organizational_units"`
+	RequiredExtensions         []string `json:"required_extensions"`
+	OCSPEnabled                bool     `json:"ocsp_enabled"`
+	OCSPCACertificates         string   `json:"ocsp_ca_certificates"`
+	OCSPServersOverride        []string `json:"ocsp_servers_override"`
+	OCSPFailOpen               bool     `json:"ocsp_fail_open"`
+	OCSPQueryAllServers        bool     `json:"ocsp_query_all_servers"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.CertRoleParameters, certificate string) *VaultCertRole {
+	return &VaultCertRole{
+		Certificate:                certificate,
+		DisplayName:                pointer.StringDeref(params.DisplayName, ""),
+		AllowedCommonNames:         params.AllowedCommonNames,
+		AllowedDNSSANs:             params.AllowedDNSSANs,
+		AllowedEmailSANs:           params.AllowedEmailSANs,
+		AllowedURISANs:             params.AllowedURISANs,
+		AllowedOrganizationalUnits: params.AllowedOrganizationalUnits,
+		RequiredExtensions:         params.RequiredExtensions,
+		OCSPEnabled:                pointer.BoolDeref(params.OCSPEnabled, false),
+		OCSPCACertificates:         pointer.StringDeref(params.OCSPCACertificates, ""),
+		OCSPServersOverride:        params.OCSPServersOverride,
+		OCSPFailOpen:               pointer.BoolDeref(params.OCSPFailOpen, false),
+		OCSPQueryAllServers:        pointer.BoolDeref(params.OCSPQueryAllServers, false),
+		VaultTokenFields:           tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultCertRole, error) {
+	role := &VaultCertRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role. The certificate read from
+// the referenced ConfigMap is always sent; the constraints and the OCSP
+// settings only when set in the managed resource.
+func encode(params v1alpha1.CertRoleParameters, certificate string) map[string]interface{} {
+	data := map[string]interface{}{
+		"certificate": certificate,
+	}
+
+	setStrings(data, "allowed_common_names", params.AllowedCommonNames)
+	setStrings(data, "allowed_dns_sans", params.AllowedDNSSANs)
+	setStrings(data, "allowed_email_sans", params.AllowedEmailSANs)
+	setStrings(data, "allowed_uri_sans", params.AllowedURISANs)
+	setStrings(data, "allowed_organizational_units", params.AllowedOrganizationalUnits)
+	setStrings(data, "required_extensions", params.RequiredExtensions)
+	setStrings(data, "ocsp_servers_override", params.OCSPServersOverride)
+
+	if params.DisplayName != nil {
+		data["display_name"] = *params.DisplayName
+	}
+	if params.OCSPEnabled != nil {
+		data["ocsp_enabled"] = *params.OCSPEnabled
+	}
+	if params.OCSPCACertificates != nil {
+		data["ocsp_ca_certificates"] = *params.OCSPCACertificates
+	}
+	if params.OCSPFailOpen != nil {
+		data["ocsp_fail_open"] = *params.OCSPFailOpen
+	}
+	if params.OCSPQueryAllServers != nil {
+		data["ocsp_query_all_servers"] = *params.OCSPQueryAllServers
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.CertRoleParameters, vaultData *VaultCertRole) bool {
+	li := false
+
+	li = clients.LateInitString(&params.DisplayName, vaultData.DisplayName) || li
+	li = clients.LateInitBool(&params.OCSPEnabled, vaultData.OCSPEnabled) || li
+	li = clients.LateInitBool(&params.OCSPFailOpen, vaultData.OCSPFailOpen) || li
+	li = clients.LateInitBool(&params.OCSPQueryAllServers, vaultData.OCSPQueryAllServers) || li
+	li = tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields) || li
+
+	return li
+}
+
+// fingerprints returns the SHA-256 fingerprints of the DER encoding of the
+// certificates in a PEM bundle, in order
+func fingerprints(bundle string) ([]string, error) {
+	fps := []string{}
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		sum := sha256.Sum256(block.Bytes)
+		fps = append(fps, hex.EncodeToString(sum[:]))
+	}
+
+	if len(fps) == 0 {
+		return nil, errors.New(errNoCertificate)
+	}
+	return fps, nil
+}
+
+func setStrings(data map[string]interface{}, key string, value []string) {
+	if value != nil {
+		data[key] = value
+	}
+}
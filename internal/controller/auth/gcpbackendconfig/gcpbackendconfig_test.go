@@ -0,0 +1,456 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpbackendconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testConfigPath  = "auth/gcp/config"
+	testCredentials = `{"type": "service_account", "project_id": "example", "client_email": "vault@example.iam.gserviceaccount.com"}`
+	testToken       = "s.provider-token"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not configured": {
+			reason: "vault returns no data for a backend that was never configured",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"deleted": {
+			reason: "a config being deleted must be reported as not existing without reading it, so that its finalizer is removed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() resource.Managed {
+					cr := getTestConfig(testCredentialsHash(testCredentials))
+					now := metav1.Now()
+					cr.SetDeletionTimestamp(&now)
+					return cr
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"error reading": {
+			reason: "backend config could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"up to date with vault defaults": {
+			reason: "parameters left unset and the credentials, which vault does not return, must not be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["gce_alias"] = "instance_id"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: credentialsSecret(testCredentials),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"outdated": {
+			reason: "a parameter set in the managed resource differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["iam_alias"] = "role_id"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: credentialsSecret(testCredentials),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"credentials changed": {
+			reason: "new credentials in the Secret must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: credentialsSecret("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"credentials not recorded": {
+			reason: "a config whose credentials hash was never recorded must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: credentialsSecret(testCredentials),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail reading credentials": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testCredentialsHash(testCredentials)),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errCredentials),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalCreation
+		hash string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "backend config must be written with the credentials read from the referenced Secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"iam_alias":   "unique_id",
+						"credentials": testCredentials,
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				kube: credentialsSecret(testCredentials),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				hash: testCredentialsHash(testCredentials),
+			},
+		},
+		"fail reading credentials": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errCredentials),
+			},
+		},
+		"fail writing": {
+			reason: "vault rejects the backend config",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			hash := tc.args.mg.(*v1alpha1.GCPBackendConfig).Status.AtProvider.CredentialsHash
+			if diff := cmp.Diff(tc.want.hash, hash); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want hash, +got hash:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func getTestConfig(hash string) *v1alpha1.GCPBackendConfig {
+	return &v1alpha1.GCPBackendConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.GCPBackendConfigKind,
+			APIVersion: v1alpha1.GCPBackendConfigKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gcp",
+		},
+		Spec: v1alpha1.GCPBackendConfigSpec{
+			ForProvider: v1alpha1.GCPBackendConfigParameters{
+				CredentialsSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "vault-gcp", Namespace: "crossplane-system"},
+					Key:             "credentials.json",
+				},
+				IAMAlias: pointer.String("unique_id"),
+			},
+		},
+		Status: v1alpha1.GCPBackendConfigStatus{
+			AtProvider: v1alpha1.GCPBackendConfigObservation{CredentialsHash: hash},
+		},
+	}
+}
+
+func credentialsSecret(credentials string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "vault-gcp" || key.Namespace != "crossplane-system" {
+				return errors.New("unexpected secret")
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"credentials.json": []byte(credentials)}
+			return nil
+		},
+	}
+}
+
+func testCredentialsHash(credentials string) string {
+	return clients.SecretHash(testToken, &v1alpha1.GCPBackendConfig{}, []byte(credentials))
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"client_email": "vault@example.iam.gserviceaccount.com",
+		"project_id":   "example",
+		"iam_alias":    "unique_id",
+		"iam_metadata": []interface{}{"project_id", "role", "service_account_id", "service_account_email"},
+		"gce_alias":    "role_id",
+		"gce_metadata": []interface{}{"instance_creation_timestamp", "instance_id", "project_id", "role", "zone"},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+	clientMock.EXPECT().Token().Return(testToken).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
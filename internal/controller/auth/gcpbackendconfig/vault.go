@@ -0,0 +1,51 @@
+package gcpbackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultGCPBackendConfig is an helper struct to compare the configuration of
+// the crossplane resource with the one vault holds. The credentials are not
+// part of it as vault never returns them.
+type VaultGCPBackendConfig struct {
+	IAMAlias string `json:"iam_alias"`
+	GCEAlias string `json:"gce_alias"`
+}
+
+func fromCrossplane(params v1alpha1.GCPBackendConfigParameters) *VaultGCPBackendConfig {
+	return &VaultGCPBackendConfig{
+		IAMAlias: pointer.StringDeref(params.IAMAlias, ""),
+		GCEAlias: pointer.StringDeref(params.GCEAlias, ""),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultGCPBackendConfig, error) {
+	config := &VaultGCPBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The
+// credentials are only sent when their secret reference is set, so that
+// vault can use the application default credentials of its host instead.
+func encode(params v1alpha1.GCPBackendConfigParameters, credentials []byte) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.IAMAlias != nil {
+		data["iam_alias"] = *params.IAMAlias
+	}
+	if params.GCEAlias != nil {
+		data["gce_alias"] = *params.GCEAlias
+	}
+	if credentials != nil {
+		data["credentials"] = string(credentials)
+	}
+
+	return data
+}
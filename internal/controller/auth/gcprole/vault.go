@@ -0,0 +1,104 @@
+package gcprole
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultGCPRole is an helper struct to compare the data from the crossplane
+// resource and with data from vault. Vault takes the bound labels as a list
+// of key:value strings, but returns them as a map.
+type VaultGCPRole struct {
+	Type                 string            `json:"role_type"`
+	BoundServiceAccounts []string          `json:"bound_service_accounts"`
+	BoundProjects        []string          `json:"bound_projects"`
+	AddGroupAliases      bool              `json:"add_group_aliases"`
+	MaxJWTExp            int               `json:"max_jwt_exp"`
+	AllowGCEInference    bool              `json:"allow_gce_inference"`
+	BoundZones           []string          `json:"bound_zones"`
+	BoundRegions         []string          `json:"bound_regions"`
+	BoundInstanceGroups  []string          `json:"bound_instance_groups"`
+	BoundLabels          map[string]string `json:"bound_labels"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.GCPRoleParameters) *VaultGCPRole {
+	return &VaultGCPRole{
+		Type:                 params.Type,
+		BoundServiceAccounts: params.BoundServiceAccounts,
+		BoundProjects:        params.BoundProjects,
+		AddGroupAliases:      pointer.BoolDeref(params.AddGroupAliases, false),
+		MaxJWTExp:            pointer.IntDeref(params.MaxJWTExp, 0),
+		AllowGCEInference:    pointer.BoolDeref(params.AllowGCEInference, false),
+		BoundZones:           params.BoundZones,
+		BoundRegions:         params.BoundRegions,
+		BoundInstanceGroups:  params.BoundInstanceGroups,
+		BoundLabels:          params.BoundLabels,
+		VaultTokenFields:     tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultGCPRole, error) {
+	role := &VaultGCPRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role. The bound labels are sent
+// as sorted key:value strings, the form vault takes them in, so that the
+// request does not depend on the order of the map.
+func encode(params v1alpha1.GCPRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"type": params.Type,
+	}
+
+	if params.AddGroupAliases != nil {
+		data["add_group_aliases"] = *params.AddGroupAliases
+	}
+	if params.MaxJWTExp != nil {
+		data["max_jwt_exp"] = *params.MaxJWTExp
+	}
+	if params.AllowGCEInference != nil {
+		data["allow_gce_inference"] = *params.AllowGCEInference
+	}
+
+	setStrings(data, "bound_service_accounts", params.BoundServiceAccounts)
+	setStrings(data, "bound_projects", params.BoundProjects)
+	setStrings(data, "bound_zones", params.BoundZones)
+	setStrings(data, "bound_regions", params.BoundRegions)
+	setStrings(data, "bound_instance_groups", params.BoundInstanceGroups)
+
+	if params.BoundLabels != nil {
+		labels := make([]string, 0, len(params.BoundLabels))
+		for k, v := range params.BoundLabels {
+			labels = append(labels, k+":"+v)
+		}
+		sort.Strings(labels)
+		data["bound_labels"] = labels
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the token parameters left unset in the managed
+// resource with the values Vault holds. It returns true when any parameter
+// was filled.
+func lateInitialize(params *v1alpha1.GCPRoleParameters, vaultData *VaultGCPRole) bool {
+	return tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields)
+}
+
+func setStrings(data map[string]interface{}, key string, value []string) {
+	if value != nil {
+		data[key] = value
+	}
+}
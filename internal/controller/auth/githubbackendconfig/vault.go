@@ -0,0 +1,56 @@
+package githubbackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultGitHubBackendConfig is an helper struct to compare the configuration
+// of the crossplane resource with the one vault holds.
+type VaultGitHubBackendConfig struct {
+	Organization   string `json:"organization"`
+	OrganizationID int    `json:"organization_id"`
+	BaseURL        string `json:"base_url"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.GitHubBackendConfigParameters) *VaultGitHubBackendConfig {
+	return &VaultGitHubBackendConfig{
+		Organization:     params.Organization,
+		OrganizationID:   pointer.IntDeref(params.OrganizationID, 0),
+		BaseURL:          pointer.StringDeref(params.BaseURL, ""),
+		VaultTokenFields: tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultGitHubBackendConfig, error) {
+	config := &VaultGitHubBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The
+// organization is always sent; its ID and the base URL only when set, since
+// vault looks the ID of the organization up otherwise.
+func encode(params v1alpha1.GitHubBackendConfigParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"organization": params.Organization,
+	}
+
+	if params.OrganizationID != nil {
+		data["organization_id"] = *params.OrganizationID
+	}
+	if params.BaseURL != nil {
+		data["base_url"] = *params.BaseURL
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
@@ -0,0 +1,38 @@
+package githubuser
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultGitHubUser is an helper struct to compare the data from the crossplane
+// resource and with data from vault. Vault keeps the policies of a mapping as
+// the comma separated string it was written with.
+type VaultGitHubUser struct {
+	Policies string `json:"value"`
+}
+
+func fromCrossplane(params v1alpha1.GitHubUserParameters) *VaultGitHubUser {
+	return &VaultGitHubUser{
+		Policies: strings.Join(params.Policies, ","),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultGitHubUser, error) {
+	mapping := &VaultGitHubUser{}
+	if err := clients.DecodeData(data, mapping); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return mapping, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.GitHubUserParameters) map[string]interface{} {
+	return map[string]interface{}{
+		"value": strings.Join(params.Policies, ","),
+	}
+}
@@ -0,0 +1,562 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwtbackendconfig
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testConfigPath   = "auth/gitlab/config"
+	testClientSecret = "s3cr3t"
+	testToken        = "s.provider-token"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not configured": {
+			reason: "vault returns no data for a backend that was never configured",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"deleted": {
+			reason: "a config being deleted must be reported as not existing without reading it, so that its finalizer is removed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() resource.Managed {
+					cr := getTestConfig(testClientSecretHash(testClientSecret))
+					now := metav1.Now()
+					cr.SetDeletionTimestamp(&now)
+					return cr
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"error reading": {
+			reason: "backend config could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"up to date with vault defaults": {
+			reason: "parameters left unset and the client secret, which vault does not return, must not be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["jwt_supported_algs"] = []interface{}{"RS256"}
+					data["namespace_in_state"] = true
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"outdated": {
+			reason: "a parameter set in the managed resource differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["default_role"] = "admin"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"provider config up to date": {
+			reason: "the booleans and integers of a provider config must compare equal to the ones vault returns",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["provider_config"] = map[string]interface{}{
+						"provider":                 "gsuite",
+						"fetch_groups":             true,
+						"groups_recurse_max_depth": json.Number("5"),
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withGSuiteProviderConfig(getTestConfig(testClientSecretHash(testClientSecret))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"provider config outdated": {
+			reason: "a value of the provider config that differs from vault must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["provider_config"] = map[string]interface{}{
+						"provider":                 "gsuite",
+						"fetch_groups":             true,
+						"groups_recurse_max_depth": json.Number("2"),
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withGSuiteProviderConfig(getTestConfig(testClientSecretHash(testClientSecret))),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"client secret changed": {
+			reason: "a new client secret in the Secret must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"client secret not recorded": {
+			reason: "a config whose client secret hash was never recorded must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail reading client secret": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testClientSecretHash(testClientSecret)),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errClientSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalCreation
+		hash string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "backend config must be written with the client secret read from the referenced Secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"oidc_discovery_url": "https://gitlab.example.com",
+						"oidc_client_id":     "vault",
+						"oidc_client_secret": "s3cr3t",
+						"bound_issuer":       "https://gitlab.example.com",
+						"default_role":       "developer",
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				kube: oidcSecret(testClientSecret),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				hash: testClientSecretHash(testClientSecret),
+			},
+		},
+		"fail reading client secret": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errClientSecret),
+			},
+		},
+		"fail validating": {
+			reason: "a config with more than one way of verifying tokens must not be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() resource.Managed {
+					cr := getTestConfig("")
+					cr.Spec.ForProvider.JWKSURL = pointer.String("https://gitlab.example.com/oauth/discovery/keys")
+					return cr
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errValidationMethods), errWrite),
+			},
+		},
+		"fail writing": {
+			reason: "vault rejects the backend config",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			hash := tc.args.mg.(*v1alpha1.JWTBackendConfig).Status.AtProvider.ClientSecretHash
+			if diff := cmp.Diff(tc.want.hash, hash); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want hash, +got hash:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func getTestConfig(hash string) *v1alpha1.JWTBackendConfig {
+	return &v1alpha1.JWTBackendConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.JWTBackendConfigKind,
+			APIVersion: v1alpha1.JWTBackendConfigKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gitlab",
+		},
+		Spec: v1alpha1.JWTBackendConfigSpec{
+			ForProvider: v1alpha1.JWTBackendConfigParameters{
+				Backend:          pointer.String("gitlab"),
+				OIDCDiscoveryURL: pointer.String("https://gitlab.example.com"),
+				OIDCClientID:     pointer.String("vault"),
+				OIDCClientSecretSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "gitlab-oidc", Namespace: "crossplane-system"},
+					Key:             "clientSecret",
+				},
+				BoundIssuer: pointer.String("https://gitlab.example.com"),
+				DefaultRole: pointer.String("developer"),
+			},
+		},
+		Status: v1alpha1.JWTBackendConfigStatus{
+			AtProvider: v1alpha1.JWTBackendConfigObservation{ClientSecretHash: hash},
+		},
+	}
+}
+
+func withGSuiteProviderConfig(cr *v1alpha1.JWTBackendConfig) *v1alpha1.JWTBackendConfig {
+	cr.Spec.ForProvider.ProviderConfig = map[string]apiextensionsv1.JSON{
+		"provider":                 {Raw: []byte(`"gsuite"`)},
+		"fetch_groups":             {Raw: []byte(`true`)},
+		"groups_recurse_max_depth": {Raw: []byte(`5`)},
+	}
+	return cr
+}
+
+func oidcSecret(clientSecret string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "gitlab-oidc" || key.Namespace != "crossplane-system" {
+				return errors.New("unexpected secret")
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"clientSecret": []byte(clientSecret)}
+			return nil
+		},
+	}
+}
+
+func testClientSecretHash(clientSecret string) string {
+	return clients.SecretHash(testToken, &v1alpha1.JWTBackendConfig{}, []byte(clientSecret))
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"oidc_discovery_url":     "https://gitlab.example.com",
+		"oidc_discovery_ca_pem":  "",
+		"oidc_client_id":         "vault",
+		"oidc_response_mode":     "",
+		"oidc_response_types":    []interface{}{},
+		"jwks_url":               "",
+		"jwks_ca_pem":            "",
+		"jwt_validation_pubkeys": []interface{}{},
+		"jwt_supported_algs":     []interface{}{},
+		"bound_issuer":           "https://gitlab.example.com",
+		"default_role":           "developer",
+		"namespace_in_state":     false,
+		"provider_config":        map[string]interface{}{},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+	clientMock.EXPECT().Token().Return(testToken).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
@@ -0,0 +1,147 @@
+package jwtbackendconfig
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/pointer"
+)
+
+// VaultJWTBackendConfig is an helper struct to compare the configuration of the
+// crossplane resource with the one vault holds. The client secret is not part
+// of it as vault never returns it.
+type VaultJWTBackendConfig struct {
+	OIDCDiscoveryURL     string                 `json:"oidc_discovery_url"`
+	OIDCDiscoveryCAPEM   string                 `json:"oidc_discovery_ca_pem"`
+	OIDCClientID         string                 `json:"oidc_client_id"`
+	OIDCResponseMode     string                 `json:"oidc_response_mode"`
+	OIDCResponseTypes    []string               `json:"oidc_response_types"`
+	JWKSURL              string                 `json:"jwks_url"`
+	JWKSCAPEM            string                 `json:"jwks_ca_pem"`
+	JWTValidationPubkeys []string               `json:"jwt_validation_pubkeys"`
+	JWTSupportedAlgs     []string               `json:"jwt_supported_algs"`
+	BoundIssuer          string                 `json:"bound_issuer"`
+	DefaultRole          string                 `json:"default_role"`
+	NamespaceInState     bool                   `json:"namespace_in_state"`
+	ProviderConfig       map[string]interface{} `json:"provider_config"`
+}
+
+// validate checks that exactly one way of verifying tokens is configured
+// Check https://developer.hashicorp.com/vault/api-docs/auth/jwt#configure to see vault constraints
+func validate(params v1alpha1.JWTBackendConfigParameters) error {
+	methods := 0
+	if params.OIDCDiscoveryURL != nil {
+		methods++
+	}
+	if params.JWKSURL != nil {
+		methods++
+	}
+	if len(params.JWTValidationPubkeys) > 0 {
+		methods++
+	}
+
+	if methods != 1 {
+		return errors.New(errValidationMethods)
+	}
+	return nil
+}
+
+func fromCrossplane(params v1alpha1.JWTBackendConfigParameters) (*VaultJWTBackendConfig, error) {
+	providerConfig, err := decodeProviderConfig(params.ProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VaultJWTBackendConfig{
+		OIDCDiscoveryURL:     pointer.StringDeref(params.OIDCDiscoveryURL, ""),
+		OIDCDiscoveryCAPEM:   pointer.StringDeref(params.OIDCDiscoveryCAPEM, ""),
+		OIDCClientID:         pointer.StringDeref(params.OIDCClientID, ""),
+		OIDCResponseMode:     pointer.StringDeref(params.OIDCResponseMode, ""),
+		OIDCResponseTypes:    params.OIDCResponseTypes,
+		JWKSURL:              pointer.StringDeref(params.JWKSURL, ""),
+		JWKSCAPEM:            pointer.StringDeref(params.JWKSCAPEM, ""),
+		JWTValidationPubkeys: params.JWTValidationPubkeys,
+		JWTSupportedAlgs:     params.JWTSupportedAlgs,
+		BoundIssuer:          pointer.StringDeref(params.BoundIssuer, ""),
+		DefaultRole:          pointer.StringDeref(params.DefaultRole, ""),
+		NamespaceInState:     pointer.BoolDeref(params.NamespaceInState, false),
+		ProviderConfig:       providerConfig,
+	}, nil
+}
+
+func fromVault(data map[string]interface{}) (*VaultJWTBackendConfig, error) {
+	config := &VaultJWTBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The
+// provider config values are sent as the JSON they hold, and the client
+// secret only when its secret reference is set, since only OIDC needs one.
+func encode(params v1alpha1.JWTBackendConfigParameters, clientSecret []byte) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	setString(data, "oidc_discovery_url", params.OIDCDiscoveryURL)
+	setString(data, "oidc_discovery_ca_pem", params.OIDCDiscoveryCAPEM)
+	setString(data, "oidc_client_id", params.OIDCClientID)
+	setString(data, "oidc_response_mode", params.OIDCResponseMode)
+	setString(data, "jwks_url", params.JWKSURL)
+	setString(data, "jwks_ca_pem", params.JWKSCAPEM)
+	setString(data, "bound_issuer", params.BoundIssuer)
+	setString(data, "default_role", params.DefaultRole)
+
+	if params.OIDCResponseTypes != nil {
+		data["oidc_response_types"] = params.OIDCResponseTypes
+	}
+	if params.JWTValidationPubkeys != nil {
+		data["jwt_validation_pubkeys"] = params.JWTValidationPubkeys
+	}
+	if params.JWTSupportedAlgs != nil {
+		data["jwt_supported_algs"] = params.JWTSupportedAlgs
+	}
+	if params.NamespaceInState != nil {
+		data["namespace_in_state"] = *params.NamespaceInState
+	}
+	if params.ProviderConfig != nil {
+		data["provider_config"] = params.ProviderConfig
+	}
+	if clientSecret != nil {
+		data["oidc_client_secret"] = string(clientSecret)
+	}
+
+	return data
+}
+
+// decodeProviderConfig decodes the values of the provider config the way the
+// vault client decodes responses, numbers as json.Number, so that they compare
+// equal to the ones vault returns
+func decodeProviderConfig(config map[string]apiextensionsv1.JSON) (map[string]interface{}, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	out := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		d := json.NewDecoder(bytes.NewReader(v.Raw))
+		d.UseNumber()
+		var value interface{}
+		if err := d.Decode(&value); err != nil {
+			return nil, errors.Wrapf(err, errProviderConfig, k)
+		}
+		out[k] = value
+	}
+	return out, nil
+}
+
+func setString(data map[string]interface{}, key string, value *string) {
+	if value != nil {
+		data[key] = *value
+	}
+}
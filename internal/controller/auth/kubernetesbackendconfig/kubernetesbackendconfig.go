@@ -0,0 +1,346 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesbackendconfig
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotKubernetesBackendConfig = "managed resource is not a KubernetesBackendConfig custom resource"
+	errNewExternalClient          = "cannot create vault client from config"
+
+	errRead        = "cannot read Kubernetes auth backend config"
+	errWrite       = "cannot write Kubernetes auth backend config"
+	errDecode      = "error decoding Kubernetes auth backend config returned by vault"
+	errReviewerJWT = "cannot get token reviewer JWT"
+	errLocalCA     = "cannot get the CA of the local cluster"
+	errLocalCAKey  = "the kube-root-ca.crt ConfigMap has no ca.crt"
+
+	errValidationHost = "kubernetesHost is required unless useLocalCluster is set"
+
+	defaultBackend = "kubernetes"
+
+	// every namespace holds the CA of the cluster in this ConfigMap
+	localCAConfigMap = "kube-root-ca.crt"
+	localCAKey       = "ca.crt"
+
+	// the namespace the provider runs in, whose kube-root-ca.crt is read
+	podNamespaceEnv     = "POD_NAMESPACE"
+	defaultPodNamespace = "default"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles KubernetesBackendConfig managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.KubernetesBackendConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.KubernetesBackendConfigGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			apiReader:    mgr.GetAPIReader(),
+			localHost:    mgr.GetConfig().Host,
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.KubernetesBackendConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	apiReader    client.Reader
+	localHost    string
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesBackendConfig)
+	if !ok {
+		return nil, errors.New(errNotKubernetesBackendConfig)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client:    vaultClient,
+		kube:      c.kube,
+		apiReader: c.apiReader,
+		localHost: c.localHost,
+		logger:    c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the token reviewer JWT from the referenced Secret
+	kube client.Client
+
+	// apiReader reads the CA of the local cluster straight from the API
+	// server, so that no ConfigMap informer is started for the whole cluster
+	apiReader client.Reader
+
+	// localHost is the host of the API server of the local cluster, as the
+	// provider reaches it
+	localHost string
+
+	logger logging.Logger
+}
+
+// Observe reads the configuration of the backend, comparing only the
+// parameters set in the managed resource or filled from the local cluster.
+// Vault does not return the token reviewer JWT, so the hash of the one in the
+// Secret is compared with the hash of the last one written instead. A config
+// being deleted is reported as not existing, as Delete leaves it in place.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesBackendConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKubernetesBackendConfig)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	secret, err := c.client.Logical().Read(configPath(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// vault returns no data while a backend is not configured
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	params, err := c.resolveLocalCluster(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	reviewerJWT, err := c.reviewerJWT(ctx, params)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := cr.Status.AtProvider.TokenReviewerJWTHash == clients.SecretHash(c.client.Token(), cr, reviewerJWT) &&
+		cmp.Equal(*fromCrossplane(params), *vaultData,
+			cmpopts.EquateEmpty(),
+			clients.IgnoreUnset(params, VaultKubernetesBackendConfig{}))
+
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the configuration of the backend
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesBackendConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKubernetesBackendConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the configuration of the backend, resolving the settings of
+// the local cluster again when they are taken from the provider's cluster.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.KubernetesBackendConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKubernetesBackendConfig)
+	}
+
+	if err := c.writeConfig(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete does nothing as vault cannot remove the configuration of a backend,
+// only the backend itself.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KubernetesBackendConfig)
+	if !ok {
+		return errors.New(errNotKubernetesBackendConfig)
+	}
+
+	c.logger.Debug("Leaving Kubernetes auth backend config in place", "path", configPath(cr.Spec.ForProvider))
+	return nil
+}
+
+func (c *external) writeConfig(ctx context.Context, cr *v1alpha1.KubernetesBackendConfig) error {
+	params, err := c.resolveLocalCluster(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	if params.KubernetesHost == "" {
+		return errors.Wrap(errors.New(errValidationHost), errWrite)
+	}
+
+	reviewerJWT, err := c.reviewerJWT(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Writing Kubernetes auth backend config", "path", configPath(params))
+	if _, err := c.client.Logical().Write(configPath(params), encode(params, reviewerJWT)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	cr.Status.AtProvider.TokenReviewerJWTHash = clients.SecretHash(c.client.Token(), cr, reviewerJWT)
+	return nil
+}
+
+// reviewerJWT reads the token reviewer JWT from the referenced Secret, leaving
+// it nil when the reference is unset
+func (c *external) reviewerJWT(ctx context.Context, params v1alpha1.KubernetesBackendConfigParameters) ([]byte, error) {
+	ref := params.TokenReviewerJWTSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+	s, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+	if err != nil {
+		return nil, errors.Wrap(err, errReviewerJWT)
+	}
+	return s, nil
+}
+
+// resolveLocalCluster returns the parameters with the host and the CA of the
+// local cluster filled in, when useLocalCluster is set and they are not. They
+// are not stored in the spec, so that a rotated CA is picked up on the next
+// poll. The host is the one the provider talks to, usually the in-cluster
+// address of the API server, which a vault outside the cluster cannot reach
+// unless kubernetesHost overrides it.
+func (c *external) resolveLocalCluster(ctx context.Context, params v1alpha1.KubernetesBackendConfigParameters) (v1alpha1.KubernetesBackendConfigParameters, error) {
+	if !pointer.BoolDeref(params.UseLocalCluster, false) {
+		return params, nil
+	}
+
+	if params.KubernetesHost == "" {
+		params.KubernetesHost = c.localHost
+	}
+
+	if params.KubernetesCACert == nil {
+		cm := &corev1.ConfigMap{}
+		if err := c.apiReader.Get(ctx, types.NamespacedName{Namespace: podNamespace(), Name: localCAConfigMap}, cm); err != nil {
+			return params, errors.Wrap(err, errLocalCA)
+		}
+		ca, ok := cm.Data[localCAKey]
+		if !ok || ca == "" {
+			return params, errors.New(errLocalCAKey)
+		}
+		params.KubernetesCACert = pointer.String(ca)
+	}
+
+	return params, nil
+}
+
+func podNamespace() string {
+	if ns := os.Getenv(podNamespaceEnv); ns != "" {
+		return ns
+	}
+	return defaultPodNamespace
+}
+
+func configPath(params v1alpha1.KubernetesBackendConfigParameters) string {
+	return "auth/" + strings.Trim(pointer.StringDeref(params.Backend, defaultBackend), "/") + "/config"
+}
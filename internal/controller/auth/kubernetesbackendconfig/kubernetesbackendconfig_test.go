@@ -0,0 +1,635 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesbackendconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testConfigPath  = "auth/cluster-a/config"
+	testCACert      = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+	testLocalHost   = "https://10.96.0.1:443"
+	testLocalCACert = "-----BEGIN CERTIFICATE-----\nLOCAL\n-----END CERTIFICATE-----"
+	testReviewerJWT = "eyJhbGciOi"
+	testToken       = "s.provider-token"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+		apiReader     client.Reader
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not configured": {
+			reason: "vault returns no data for a backend that was never configured",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"deleted": {
+			reason: "a config being deleted must be reported as not existing without reading it, so that its finalizer is removed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() resource.Managed {
+					cr := getTestConfig(testReviewerJWTHash(testReviewerJWT))
+					now := metav1.Now()
+					cr.SetDeletionTimestamp(&now)
+					return cr
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"error reading": {
+			reason: "backend config could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"up to date with vault defaults": {
+			reason: "parameters left unset and the token reviewer JWT, which vault does not return, must not be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["disable_iss_validation"] = true
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: reviewerJWTSecret(testReviewerJWT),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"outdated": {
+			reason: "the CA certificate differs from vault",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["kubernetes_ca_cert"] = "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				kube: reviewerJWTSecret(testReviewerJWT),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"up to date with the local cluster": {
+			reason: "the host and CA of the local cluster must be compared when useLocalCluster is set",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["kubernetes_host"] = testLocalHost
+					data["kubernetes_ca_cert"] = testLocalCACert
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: localCAGetFn(testLocalCACert),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestLocalConfig(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"local cluster CA rotated": {
+			reason: "a rotated CA of the local cluster must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["kubernetes_host"] = testLocalHost
+					data["kubernetes_ca_cert"] = testLocalCACert
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: localCAGetFn("-----BEGIN CERTIFICATE-----\nROTATED\n-----END CERTIFICATE-----"),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestLocalConfig(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"error reading the local CA": {
+			reason: "the kube-root-ca.crt ConfigMap could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestLocalConfig(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errLocalCA),
+			},
+		},
+		"local CA missing": {
+			reason: "a kube-root-ca.crt ConfigMap without ca.crt must return an error instead of writing an empty CA",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestLocalConfig(),
+			},
+			want: want{
+				err: errors.New(errLocalCAKey),
+			},
+		},
+		"token reviewer JWT changed": {
+			reason: "a new token reviewer JWT in the Secret must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: reviewerJWTSecret("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"token reviewer JWT not recorded": {
+			reason: "a config whose token reviewer JWT hash was never recorded must be written again",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: reviewerJWTSecret(testReviewerJWT),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail reading token reviewer JWT": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testConfigPath).Return(&api.Secret{Data: getVaultData()}, nil)
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(testReviewerJWTHash(testReviewerJWT)),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errReviewerJWT),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client:    tc.fields.clientBuilder(t),
+				kube:      tc.fields.kube,
+				apiReader: tc.fields.apiReader,
+				localHost: testLocalHost,
+				logger:    logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+		apiReader     client.Reader
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o    managed.ExternalCreation
+		hash string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "backend config must be written with the token reviewer JWT read from the referenced Secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"kubernetes_host":    "https://cluster-a.example.com:6443",
+						"kubernetes_ca_cert": testCACert,
+						"token_reviewer_jwt": "eyJhbGciOi",
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				kube: reviewerJWTSecret(testReviewerJWT),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				hash: testReviewerJWTHash(testReviewerJWT),
+			},
+		},
+		"fail reading token reviewer JWT": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errReviewerJWT),
+			},
+		},
+		"fail writing": {
+			reason: "vault rejects the backend config",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestConfig(""),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+		"create from the local cluster": {
+			reason: "the host and CA of the local cluster must be written when useLocalCluster is set",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"kubernetes_host":    testLocalHost,
+						"kubernetes_ca_cert": testLocalCACert,
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testConfigPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+				apiReader: &test.MockClient{
+					MockGet: localCAGetFn(testLocalCACert),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestLocalConfig(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail without host": {
+			reason: "a config without host must not be written unless useLocalCluster is set",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() resource.Managed {
+					cr := getTestLocalConfig()
+					cr.Spec.ForProvider.UseLocalCluster = nil
+					return cr
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errValidationHost), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client:    tc.fields.clientBuilder(t),
+				kube:      tc.fields.kube,
+				apiReader: tc.fields.apiReader,
+				localHost: testLocalHost,
+				logger:    logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			hash := tc.args.mg.(*v1alpha1.KubernetesBackendConfig).Status.AtProvider.TokenReviewerJWTHash
+			if diff := cmp.Diff(tc.want.hash, hash); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want hash, +got hash:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func getTestConfig(hash string) *v1alpha1.KubernetesBackendConfig {
+	return &v1alpha1.KubernetesBackendConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.KubernetesBackendConfigKind,
+			APIVersion: v1alpha1.KubernetesBackendConfigKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-a",
+		},
+		Spec: v1alpha1.KubernetesBackendConfigSpec{
+			ForProvider: v1alpha1.KubernetesBackendConfigParameters{
+				Backend:          pointer.String("cluster-a"),
+				KubernetesHost:   "https://cluster-a.example.com:6443",
+				KubernetesCACert: pointer.String(testCACert),
+				TokenReviewerJWTSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "vault-reviewer", Namespace: "crossplane-system"},
+					Key:             "token",
+				},
+			},
+		},
+		Status: v1alpha1.KubernetesBackendConfigStatus{
+			AtProvider: v1alpha1.KubernetesBackendConfigObservation{TokenReviewerJWTHash: hash},
+		},
+	}
+}
+
+func reviewerJWTSecret(reviewerJWT string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "vault-reviewer" || key.Namespace != "crossplane-system" {
+				return errors.New("unexpected secret")
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte(reviewerJWT)}
+			return nil
+		},
+	}
+}
+
+func testReviewerJWTHash(reviewerJWT string) string {
+	return clients.SecretHash(testToken, &v1alpha1.KubernetesBackendConfig{}, []byte(reviewerJWT))
+}
+
+func getTestLocalConfig() *v1alpha1.KubernetesBackendConfig {
+	cr := getTestConfig("")
+	cr.Spec.ForProvider = v1alpha1.KubernetesBackendConfigParameters{
+		Backend:         pointer.String("cluster-a"),
+		UseLocalCluster: pointer.Bool(true),
+	}
+	return cr
+}
+
+func localCAGetFn(ca string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		if key.Name != localCAConfigMap || key.Namespace != podNamespace() {
+			return errors.New("unexpected config map")
+		}
+		obj.(*corev1.ConfigMap).Data = map[string]string{localCAKey: ca}
+		return nil
+	}
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"kubernetes_host":        "https://cluster-a.example.com:6443",
+		"kubernetes_ca_cert":     testCACert,
+		"pem_keys":               []interface{}{},
+		"issuer":                 "",
+		"disable_iss_validation": false,
+		"disable_local_ca_jwt":   false,
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+	clientMock.EXPECT().Token().Return(testToken).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
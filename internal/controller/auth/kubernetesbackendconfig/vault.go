@@ -0,0 +1,70 @@
+package kubernetesbackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultKubernetesBackendConfig is an helper struct to compare the configuration
+// of the crossplane resource with the one vault holds. The token reviewer JWT
+// is not part of it as vault never returns it.
+type VaultKubernetesBackendConfig struct {
+	KubernetesHost       string   `json:"kubernetes_host"`
+	KubernetesCACert     string   `json:"kubernetes_ca_cert"`
+	PEMKeys              []string `json:"pem_keys"`
+	Issuer               string   `json:"issuer"`
+	DisableISSValidation bool     `json:"disable_iss_validation"`
+	DisableLocalCAJWT    bool     `json:"disable_local_ca_jwt"`
+}
+
+func fromCrossplane(params v1alpha1.KubernetesBackendConfigParameters) *VaultKubernetesBackendConfig {
+	return &VaultKubernetesBackendConfig{
+		KubernetesHost:       params.KubernetesHost,
+		KubernetesCACert:     pointer.StringDeref(params.KubernetesCACert, ""),
+		PEMKeys:              params.PEMKeys,
+		Issuer:               pointer.StringDeref(params.Issuer, ""),
+		DisableISSValidation: pointer.BoolDeref(params.DisableISSValidation, false),
+		DisableLocalCAJWT:    pointer.BoolDeref(params.DisableLocalCAJWT, false),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultKubernetesBackendConfig, error) {
+	config := &VaultKubernetesBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The token
+// reviewer JWT is only sent when its secret reference is set, so that vault
+// can use its own service account token, or the JWT of each login, instead.
+func encode(params v1alpha1.KubernetesBackendConfigParameters, tokenReviewerJWT []byte) map[string]interface{} {
+	data := map[string]interface{}{
+		"kubernetes_host": params.KubernetesHost,
+	}
+
+	if params.KubernetesCACert != nil {
+		data["kubernetes_ca_cert"] = *params.KubernetesCACert
+	}
+	if params.PEMKeys != nil {
+		data["pem_keys"] = params.PEMKeys
+	}
+	if params.Issuer != nil {
+		data["issuer"] = *params.Issuer
+	}
+	if params.DisableISSValidation != nil {
+		data["disable_iss_validation"] = *params.DisableISSValidation
+	}
+	if params.DisableLocalCAJWT != nil {
+		data["disable_local_ca_jwt"] = *params.DisableLocalCAJWT
+	}
+	if tokenReviewerJWT != nil {
+		data["token_reviewer_jwt"] = string(tokenReviewerJWT)
+	}
+
+	return data
+}
@@ -0,0 +1,70 @@
+package kubernetesrole
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultKubernetesRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultKubernetesRole struct {
+	BoundServiceAccountNames      []string `json:"bound_service_account_names"`
+	BoundServiceAccountNamespaces []string `json:"bound_service_account_namespaces"`
+	Audience                      string   `json:"audience"`
+	AliasNameSource               string   `json:"alias_name_source"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.KubernetesRoleParameters) *VaultKubernetesRole {
+	return &VaultKubernetesRole{
+		BoundServiceAccountNames:      params.BoundServiceAccountNames,
+		BoundServiceAccountNamespaces: params.BoundServiceAccountNamespaces,
+		Audience:                      pointer.StringDeref(params.Audience, ""),
+		AliasNameSource:               pointer.StringDeref(params.AliasNameSource, ""),
+		VaultTokenFields:              tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultKubernetesRole, error) {
+	role := &VaultKubernetesRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role. The bound service account
+// names and namespaces, which vault requires, are always sent.
+func encode(params v1alpha1.KubernetesRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"bound_service_account_names":      params.BoundServiceAccountNames,
+		"bound_service_account_namespaces": params.BoundServiceAccountNamespaces,
+	}
+
+	if params.Audience != nil {
+		data["audience"] = *params.Audience
+	}
+	if params.AliasNameSource != nil {
+		data["alias_name_source"] = *params.AliasNameSource
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.KubernetesRoleParameters, vaultData *VaultKubernetesRole) bool {
+	li := false
+
+	li = clients.LateInitString(&params.Audience, vaultData.Audience) || li
+	li = clients.LateInitString(&params.AliasNameSource, vaultData.AliasNameSource) || li
+	li = tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields) || li
+
+	return li
+}
@@ -0,0 +1,112 @@
+package ldapbackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultLDAPBackendConfig is an helper struct to compare the configuration of
+// the crossplane resource with the one vault holds. The bind password is not
+// part of it as vault never returns it.
+type VaultLDAPBackendConfig struct {
+	URL                string `json:"url"`
+	BindDN             string `json:"binddn"`
+	UserDN             string `json:"userdn"`
+	UserAttr           string `json:"userattr"`
+	UserFilter         string `json:"userfilter"`
+	UPNDomain          string `json:"upndomain"`
+	DiscoverDN         bool   `json:"discoverdn"`
+	DenyNullBind       bool   `json:"deny_null_bind"`
+	GroupDN            string `json:"groupdn"`
+	GroupFilter        string `json:"groupfilter"`
+	GroupAttr          string `json:"groupattr"`
+	Certificate        string `json:"certificate"`
+	InsecureTLS        bool   `json:"insecure_tls"`
+	StartTLS           bool   `json:"starttls"`
+	TLSMinVersion      string `json:"tls_min_version"`
+	CaseSensitiveNames bool   `json:"case_sensitive_names"`
+	UsernameAsAlias    bool   `json:"username_as_alias"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.LDAPBackendConfigParameters) *VaultLDAPBackendConfig {
+	return &VaultLDAPBackendConfig{
+		URL:                params.URL,
+		BindDN:             pointer.StringDeref(params.BindDN, ""),
+		UserDN:             pointer.StringDeref(params.UserDN, ""),
+		UserAttr:           pointer.StringDeref(params.UserAttr, ""),
+		UserFilter:         pointer.StringDeref(params.UserFilter, ""),
+		UPNDomain:          pointer.StringDeref(params.UPNDomain, ""),
+		DiscoverDN:         pointer.BoolDeref(params.DiscoverDN, false),
+		DenyNullBind:       pointer.BoolDeref(params.DenyNullBind, false),
+		GroupDN:            pointer.StringDeref(params.GroupDN, ""),
+		GroupFilter:        pointer.StringDeref(params.GroupFilter, ""),
+		GroupAttr:          pointer.StringDeref(params.GroupAttr, ""),
+		Certificate:        pointer.StringDeref(params.Certificate, ""),
+		InsecureTLS:        pointer.BoolDeref(params.InsecureTLS, false),
+		StartTLS:           pointer.BoolDeref(params.StartTLS, false),
+		TLSMinVersion:      pointer.StringDeref(params.TLSMinVersion, ""),
+		CaseSensitiveNames: pointer.BoolDeref(params.CaseSensitiveNames, false),
+		UsernameAsAlias:    pointer.BoolDeref(params.UsernameAsAlias, false),
+		VaultTokenFields:   tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultLDAPBackendConfig, error) {
+	config := &VaultLDAPBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The bind
+// password is only sent when its secret reference is set, which backends
+// binding anonymously do without.
+func encode(params v1alpha1.LDAPBackendConfigParameters, bindPass []byte) map[string]interface{} {
+	data := map[string]interface{}{
+		"url": params.URL,
+	}
+
+	setString(data, "binddn", params.BindDN)
+	setString(data, "userdn", params.UserDN)
+	setString(data, "userattr", params.UserAttr)
+	setString(data, "userfilter", params.UserFilter)
+	setString(data, "upndomain", params.UPNDomain)
+	setString(data, "groupdn", params.GroupDN)
+	setString(data, "groupfilter", params.GroupFilter)
+	setString(data, "groupattr", params.GroupAttr)
+	setString(data, "certificate", params.Certificate)
+	setString(data, "tls_min_version", params.TLSMinVersion)
+
+	setBool(data, "discoverdn", params.DiscoverDN)
+	setBool(data, "deny_null_bind", params.DenyNullBind)
+	setBool(data, "insecure_tls", params.InsecureTLS)
+	setBool(data, "starttls", params.StartTLS)
+	setBool(data, "case_sensitive_names", params.CaseSensitiveNames)
+	setBool(data, "username_as_alias", params.UsernameAsAlias)
+
+	if bindPass != nil {
+		data["bindpass"] = string(bindPass)
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+func setString(data map[string]interface{}, key string, value *string) {
+	if value != nil {
+		data[key] = *value
+	}
+}
+
+func setBool(data map[string]interface{}, key string, value *bool) {
+	if value != nil {
+		data[key] = *value
+	}
+}
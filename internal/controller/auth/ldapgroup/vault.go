@@ -0,0 +1,38 @@
+package ldapgroup
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultLDAPGroup is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultLDAPGroup struct {
+	Policies []string `json:"policies"`
+}
+
+func fromCrossplane(params v1alpha1.LDAPGroupParameters) *VaultLDAPGroup {
+	return &VaultLDAPGroup{
+		Policies: params.Policies,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultLDAPGroup, error) {
+	group := &VaultLDAPGroup{}
+	if err := clients.DecodeData(data, group); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return group, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.LDAPGroupParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.Policies != nil {
+		data["policies"] = params.Policies
+	}
+
+	return data
+}
@@ -0,0 +1,47 @@
+package ldapuser
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultLDAPUser is an helper struct to compare the data from the crossplane
+// resource and with data from vault. Vault returns the groups of a user as a
+// comma separated string.
+type VaultLDAPUser struct {
+	Policies []string `json:"policies"`
+	Groups   string   `json:"groups"`
+}
+
+func fromCrossplane(params v1alpha1.LDAPUserParameters) *VaultLDAPUser {
+	return &VaultLDAPUser{
+		Policies: params.Policies,
+		Groups:   strings.Join(params.Groups, ","),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultLDAPUser, error) {
+	user := &VaultLDAPUser{}
+	if err := clients.DecodeData(data, user); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return user, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.LDAPUserParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.Policies != nil {
+		data["policies"] = params.Policies
+	}
+	if params.Groups != nil {
+		data["groups"] = strings.Join(params.Groups, ",")
+	}
+
+	return data
+}
@@ -0,0 +1,60 @@
+package oktabackendconfig
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultOktaBackendConfig is an helper struct to compare the configuration of
+// the crossplane resource with the one vault holds. The API token is not part
+// of it as vault never returns it.
+type VaultOktaBackendConfig struct {
+	OrgName       string `json:"org_name"`
+	BaseURL       string `json:"base_url"`
+	BypassOktaMFA bool   `json:"bypass_okta_mfa"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.OktaBackendConfigParameters) *VaultOktaBackendConfig {
+	return &VaultOktaBackendConfig{
+		OrgName:          params.OrgName,
+		BaseURL:          pointer.StringDeref(params.BaseURL, ""),
+		BypassOktaMFA:    pointer.BoolDeref(params.BypassOktaMFA, false),
+		VaultTokenFields: tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultOktaBackendConfig, error) {
+	config := &VaultOktaBackendConfig{}
+	if err := clients.DecodeData(data, config); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return config, nil
+}
+
+// encode builds the body of a write to the backend configuration. The API
+// token is only sent when its secret reference is set; without one, vault
+// can authenticate users but not read their Okta groups.
+func encode(params v1alpha1.OktaBackendConfigParameters, apiToken []byte) map[string]interface{} {
+	data := map[string]interface{}{
+		"org_name": params.OrgName,
+	}
+
+	if params.BaseURL != nil {
+		data["base_url"] = *params.BaseURL
+	}
+	if params.BypassOktaMFA != nil {
+		data["bypass_okta_mfa"] = *params.BypassOktaMFA
+	}
+	if apiToken != nil {
+		data["api_token"] = string(apiToken)
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
@@ -0,0 +1,38 @@
+package oktagroup
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultOktaGroup is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultOktaGroup struct {
+	Policies []string `json:"policies"`
+}
+
+func fromCrossplane(params v1alpha1.OktaGroupParameters) *VaultOktaGroup {
+	return &VaultOktaGroup{
+		Policies: params.Policies,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultOktaGroup, error) {
+	group := &VaultOktaGroup{}
+	if err := clients.DecodeData(data, group); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return group, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.OktaGroupParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.Policies != nil {
+		data["policies"] = params.Policies
+	}
+
+	return data
+}
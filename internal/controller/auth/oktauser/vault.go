@@ -0,0 +1,44 @@
+package oktauser
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultOktaUser is an helper struct to compare the data from the crossplane
+// resource and with data from vault
+type VaultOktaUser struct {
+	Policies []string `json:"policies"`
+	Groups   []string `json:"groups"`
+}
+
+func fromCrossplane(params v1alpha1.OktaUserParameters) *VaultOktaUser {
+	return &VaultOktaUser{
+		Policies: params.Policies,
+		Groups:   params.Groups,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultOktaUser, error) {
+	user := &VaultOktaUser{}
+	if err := clients.DecodeData(data, user); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return user, nil
+}
+
+// encode prepares the data to be sent to vault
+func encode(params v1alpha1.OktaUserParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.Policies != nil {
+		data["policies"] = params.Policies
+	}
+	if params.Groups != nil {
+		data["groups"] = params.Groups
+	}
+
+	return data
+}
@@ -19,8 +19,8 @@ package role
 import (
 	"context"
 	"encoding/json"
-	"reflect"
 	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -31,11 +31,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
 	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
 	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
 	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -47,12 +51,27 @@ const (
 	errCreation = "cannot create JWT/OIDC role"
 	errUpdate   = "cannot update JWT/OIDC role"
 	errDelete   = "cannot delete JWT/OIDC role"
+	errRead     = "cannot read JWT/OIDC role"
 
 	errDecodingData = "cannot decode JWT/OIDC spec"
+	errAuthURL      = "cannot request OIDC auth URL"
+
+	// redirectURIsVerifyInterval is how long the redirect URIs of an unchanged
+	// role stay verified, so that changes to the configuration of the backend
+	// or of the OIDC provider are eventually reported
+	redirectURIsVerifyInterval = time.Hour
 
 	errValidationClockSkewLeeway  = "clock_skew_leeway only applicable for JWT roles"
 	errValidationNotBeforeLeeway  = "not_before_leeway only applicable for JWT roles"
 	errValidationExpirationLeeway = "expiration_leeway only applicable for JWT roles"
+	errValidationRoleType         = "role_type must be one of jwt or oidc"
+	errValidationBoundAudiences   = "bound_audiences is required for JWT roles"
+	errValidationRedirectURIs     = "allowed_redirect_uris is required for OIDC roles"
+	errValidationUserClaim        = "user_claim is required"
+	errValidationJSONPointer      = "user_claim must be a JSON pointer, starting with /, when user_claim_json_pointer is set"
+	errValidationBoundClaimsType  = "bound_claims_type must be one of string or glob"
+	errValidationTokenType        = "token_type must be one of service, batch or default"
+	errValidationTokenTTL         = "token_ttl cannot be greater than token_max_ttl"
 )
 
 // A NoOpService does nothing.
@@ -140,22 +159,26 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	exists := false
 	upToDate := true
+	lateInitialized := false
 
 	path := jwtAuthBackendRolePath(*role.Spec.ForProvider.Backend, meta.GetExternalName(role))
 	response, err := c.client.Logical().Read(path)
 	if response != nil && err == nil {
 		exists = true
 
-		crossplaneData := fromCrossplane(role)
 		vaultData, err := fromVault(response.Data)
 		if err != nil {
-			c.logger.Debug("error decoding response from vault: %s", err.Error())
+			return managed.ExternalObservation{}, errors.Wrap(err, errRead)
 		}
 
-		// Set this in the struct in order to compare
-		vaultData.Name = meta.GetExternalName(role)
+		lateInitialized = lateInitialize(&role.Spec.ForProvider, vaultData)
+		upToDate = isUpToDate(role, vaultData)
 
-		upToDate = reflect.DeepEqual(*crossplaneData, *vaultData)
+		if upToDate && vaultData.RoleType == "oidc" && !meta.WasDeleted(role) && !redirectURIsVerified(role) {
+			if err := c.verifyRedirectURIs(role); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errAuthURL)
+			}
+		}
 	}
 
 	if exists && upToDate {
@@ -173,6 +196,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// resource reconciler know that it needs to call Update.
 		ResourceUpToDate: upToDate,
 
+		// Return true when optional fields left unset in the managed resource
+		// were filled with the values Vault holds, so that the spec is
+		// persisted before the status.
+		ResourceLateInitialized: lateInitialized,
+
 		// Return any details that may be required to connect to the external
 		// resource. These will be stored as the connection secret.
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -229,6 +257,11 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
 	}
 
+	// the redirect URIs are verified again once the updated role is observed
+	role.Status.AtProvider.AcceptedRedirectURIs = nil
+	role.Status.AtProvider.RejectedRedirectURIs = nil
+	role.Status.AtProvider.RedirectURIsVerifiedTime = nil
+
 	return managed.ExternalUpdate{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
@@ -251,6 +284,55 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return nil
 }
 
+// verifyRedirectURIs starts an OIDC login with each allowed redirect URI of
+// the role, as the vault UI and CLI do, and reports in the status the ones
+// vault accepts. Vault answers with an empty auth URL for a redirect URI it
+// does not allow. It fails when it cannot reach the OIDC provider, which
+// tells nothing about the redirect URI, so the error is returned and the
+// status is left as it was.
+func (c *external) verifyRedirectURIs(role *v1alpha1.Role) error {
+	var accepted, rejected []string
+
+	path := oidcAuthURLPath(*role.Spec.ForProvider.Backend)
+	for _, uri := range role.Spec.ForProvider.AllowedRedirectURIs {
+		response, err := c.client.Logical().Write(path, map[string]interface{}{
+			"role":         meta.GetExternalName(role),
+			"redirect_uri": uri,
+		})
+		if err != nil {
+			return err
+		}
+
+		if response != nil && response.Data["auth_url"] != nil && response.Data["auth_url"] != "" {
+			accepted = append(accepted, uri)
+		} else {
+			rejected = append(rejected, uri)
+		}
+	}
+
+	now := metav1.Now()
+	role.Status.AtProvider.AcceptedRedirectURIs = accepted
+	role.Status.AtProvider.RejectedRedirectURIs = rejected
+	role.Status.AtProvider.RedirectURIsVerifiedTime = &now
+	return nil
+}
+
+// redirectURIsVerified tells whether the status reports each allowed redirect
+// URI of the role as accepted or rejected, as verified less than
+// redirectURIsVerifyInterval ago. Vault is then only asked to start a login
+// when the role is written, its redirect URIs change, or the verification is
+// due again, rather than on every poll.
+func redirectURIsVerified(role *v1alpha1.Role) bool {
+	obs := role.Status.AtProvider
+	if obs.RedirectURIsVerifiedTime == nil || time.Since(obs.RedirectURIsVerifiedTime.Time) >= redirectURIsVerifyInterval {
+		return false
+	}
+	verified := append(append([]string{}, obs.AcceptedRedirectURIs...), obs.RejectedRedirectURIs...)
+	return cmp.Equal(role.Spec.ForProvider.AllowedRedirectURIs, verified,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
 func decodeData(data *Role) (map[string]interface{}, error) {
 	vaultData := map[string]interface{}{}
 
@@ -266,3 +348,102 @@ func decodeData(data *Role) (map[string]interface{}, error) {
 func jwtAuthBackendRolePath(backend, role string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(role, "/")
 }
+
+func oidcAuthURLPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/oidc/auth_url"
+}
+
+// isUpToDate compares only the parameters set in the managed resource, so
+// that Vault's defaults for the ones left unset are not reported as drift.
+func isUpToDate(role *v1alpha1.Role, vaultData *Role) bool {
+	crossplaneData := fromCrossplane(role)
+
+	// vault does not return these, set them in the struct in order to compare
+	vaultData.Name = crossplaneData.Name
+	vaultData.Namespace = crossplaneData.Namespace
+
+	return cmp.Equal(*crossplaneData, *vaultData, cmpopts.EquateEmpty(), clients.IgnoreUnset(role.Spec.ForProvider, Role{}))
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults such as
+// the role and token types. It returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.RoleParameters, vaultData *Role) bool {
+	li := false
+
+	li = clients.LateInitString(&params.RoleType, vaultData.RoleType) || li
+	li = lateInitStrings(&params.BoundAudiences, vaultData.BoundAudiences) || li
+	li = clients.LateInitString(&params.UserClaim, vaultData.UserClaim) || li
+	li = clients.LateInitBool(&params.UserClaimJSONPointer, vaultData.UserClaimJSONPointer) || li
+	li = clients.LateInitString(&params.BoundSubject, vaultData.BoundSubject) || li
+	li = lateInitStringMap(&params.BoundClaims, vaultData.BoundClaims) || li
+	li = clients.LateInitString(&params.BoundClaimsType, vaultData.BoundClaimsType) || li
+	li = lateInitStringMap(&params.ClaimMappings, vaultData.ClaimMappings) || li
+	li = lateInitStrings(&params.OIDCScopes, vaultData.OIDCScopes) || li
+	li = clients.LateInitString(&params.GroupsClaim, vaultData.GroupsClaim) || li
+	li = lateInitStrings(&params.AllowedRedirectURIs, vaultData.AllowedRedirectURIs) || li
+	li = lateInitInt(&params.ClockSkewLeeway, vaultData.ClockSkewLeeway) || li
+	li = lateInitInt(&params.ExpirationLeeway, vaultData.ExpirationLeeway) || li
+	li = lateInitInt(&params.NotBeforeLeeway, vaultData.NotBeforeLeeway) || li
+	li = clients.LateInitBool(&params.VerboseOIDCLogging, vaultData.VerboseOIDCLogging) || li
+	li = lateInitInt(&params.MaxAge, vaultData.MaxAge) || li
+	li = lateInitInt(&params.TokenTTL, vaultData.TokenTTL) || li
+	li = lateInitInt(&params.TokenMaxTTL, vaultData.TokenMaxTTL) || li
+	li = lateInitStrings(&params.TokenPolicies, vaultData.TokenPolicies) || li
+	li = lateInitStrings(&params.TokenBoundCIDRS, vaultData.TokenBoundCIDRS) || li
+	li = lateInitInt(&params.TokenExplicitMaxTTL, vaultData.TokenExplicitMaxTTL) || li
+	li = clients.LateInitBool(&params.TokenNoDefaultPolicy, vaultData.TokenNoDefaultPolicy) || li
+	li = lateInitInt(&params.TokenNumUses, vaultData.TokenNumUses) || li
+	li = lateInitInt(&params.TokenPeriod, vaultData.TokenPeriod) || li
+	li = clients.LateInitString(&params.TokenType, vaultData.TokenType) || li
+
+	return li
+}
+
+func lateInitInt(param **int, vault json.Number) bool {
+	n, err := vault.Int64()
+	if *param != nil || err != nil || n == 0 {
+		return false
+	}
+	*param = pointer.Int(int(n))
+	return true
+}
+
+// lateInitStrings fills an unset list parameter with the list Vault holds,
+// only when all its values are strings, which the parameter can hold as they
+// are.
+func lateInitStrings(param *[]string, vault []interface{}) bool {
+	if *param != nil || len(vault) == 0 {
+		return false
+	}
+	values := make([]string, 0, len(vault))
+	for _, v := range vault {
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		values = append(values, s)
+	}
+	*param = values
+	return true
+}
+
+// lateInitStringMap fills an unset map parameter with the map Vault holds,
+// only when all its values are strings. Vault also accepts lists as values,
+// such as the bound claims matching any of several values, which a
+// map[string]string cannot hold without corrupting them.
+func lateInitStringMap(param *map[string]string, vault map[string]interface{}) bool {
+	if *param != nil || len(vault) == 0 {
+		return false
+	}
+	values := make(map[string]string, len(vault))
+	for k, v := range vault {
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		values[k] = s
+	}
+	*param = values
+	return true
+}
@@ -18,15 +18,19 @@ package role
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
 	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
@@ -55,8 +59,11 @@ func TestObserve(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalObservation
-		err error
+		o          managed.ExternalObservation
+		atProvider v1alpha1.RoleObservation
+		// whether the status records when the redirect URIs were verified
+		verified bool
+		err      error
 	}
 
 	cases := map[string]struct {
@@ -175,11 +182,314 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"server defaults are not drift": {
+			reason: "role exists with vault defaults for the fields left unset, which are late initialized",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestRole()
+
+					data := getVaultDefaultData(meta.GetExternalName(role))
+					data["bound_audiences"] = []interface{}{"test"}
+					data["bound_claims_type"] = "string"
+					data["token_type"] = "default"
+					data["max_age"] = json.Number("300")
+					data["token_policies"] = []interface{}{"default"}
+
+					path := jwtAuthBackendRolePath(*role.Spec.ForProvider.Backend, meta.GetExternalName(role))
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(path).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
+		"oidc role redirect URIs are verified": {
+			reason: "an up to date oidc role must report which allowed redirect URIs vault starts a login with",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					clientMock.EXPECT().Logical().Return(logicalMock).Times(2)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+					logicalMock.EXPECT().Write(oidcAuthURLPath("oidc"), map[string]interface{}{
+						"role":         name,
+						"redirect_uri": "https://vault.example.com/ui/vault/auth/oidc/oidc/callback",
+					}).Return(&api.Secret{Data: map[string]interface{}{"auth_url": "https://idp.example.com/authorize?state=abc"}}, nil)
+					logicalMock.EXPECT().Write(oidcAuthURLPath("oidc"), map[string]interface{}{
+						"role":         name,
+						"redirect_uri": "http://localhost:8250/oidc/callback",
+					}).Return(&api.Secret{Data: map[string]interface{}{"auth_url": ""}}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestOIDCRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.RoleObservation{
+					AcceptedRedirectURIs: []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"},
+					RejectedRedirectURIs: []string{"http://localhost:8250/oidc/callback"},
+				},
+				verified: true,
+				err:      nil,
+			},
+		},
+		"oidc provider unreachable": {
+			reason: "an allowed redirect URI vault fails to start a login with must not be reported as rejected, as the failure tells nothing about it",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					role.Spec.ForProvider.AllowedRedirectURIs = []string{"http://localhost:8250/oidc/callback"}
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					clientMock.EXPECT().Logical().Return(logicalMock)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+					logicalMock.EXPECT().Write(oidcAuthURLPath("oidc"), gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.Role {
+					role := getTestOIDCRole()
+					role.Spec.ForProvider.AllowedRedirectURIs = []string{"http://localhost:8250/oidc/callback"}
+					return role
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errAuthURL),
+			},
+		},
+		"oidc role redirect URIs already verified": {
+			reason: "vault must not be asked to start a login again while the allowed redirect URIs stay the ones verified",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVerifiedRedirectURIs(getTestOIDCRole()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.RoleObservation{
+					AcceptedRedirectURIs: []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"},
+					RejectedRedirectURIs: []string{"http://localhost:8250/oidc/callback"},
+				},
+				verified: true,
+				err:      nil,
+			},
+		},
+		"oidc role redirect URI added": {
+			reason: "the allowed redirect URIs must be verified again when they differ from the ones verified",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					clientMock.EXPECT().Logical().Return(logicalMock).Times(2)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+					logicalMock.EXPECT().Write(oidcAuthURLPath("oidc"), gomock.Any()).Return(&api.Secret{Data: map[string]interface{}{"auth_url": "https://idp.example.com/authorize?state=abc"}}, nil).Times(2)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.Role {
+					role := getTestOIDCRole()
+					role.Status.AtProvider.AcceptedRedirectURIs = []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"}
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.RoleObservation{
+					AcceptedRedirectURIs: []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"},
+				},
+				verified: true,
+				err:      nil,
+			},
+		},
+		"oidc role redirect URIs verification due": {
+			reason: "the allowed redirect URIs must be verified again once the last verification is too old",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					clientMock.EXPECT().Logical().Return(logicalMock).Times(2)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+					logicalMock.EXPECT().Write(oidcAuthURLPath("oidc"), gomock.Any()).Return(&api.Secret{Data: map[string]interface{}{"auth_url": "https://idp.example.com/authorize?state=abc"}}, nil).Times(2)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.Role {
+					role := withVerifiedRedirectURIs(getTestOIDCRole())
+					verified := metav1.NewTime(time.Now().Add(-2 * redirectURIsVerifyInterval))
+					role.Status.AtProvider.RedirectURIsVerifiedTime = &verified
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.RoleObservation{
+					AcceptedRedirectURIs: []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"},
+				},
+				verified: true,
+				err:      nil,
+			},
+		},
+		"oidc role deleted": {
+			reason: "vault must not be asked to start a login for a role being deleted",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					name := meta.GetExternalName(role)
+
+					data := getVaultDefaultData(name)
+					data["role_type"] = "oidc"
+					data["bound_audiences"] = []interface{}{"test"}
+					data["allowed_redirect_uris"] = []interface{}{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback", "http://localhost:8250/oidc/callback"}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(jwtAuthBackendRolePath("oidc", name)).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg: func() *v1alpha1.Role {
+					role := getTestOIDCRole()
+					now := metav1.Now()
+					role.SetDeletionTimestamp(&now)
+					return role
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
+		"user set field drifted": {
+			reason: "a field set in the managed resource that differs from vault must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestRole()
+
+					data := getVaultDefaultData(meta.GetExternalName(role))
+					data["bound_audiences"] = []interface{}{"test"}
+					data["user_claim"] = "sub"
+
+					path := jwtAuthBackendRolePath(*role.Spec.ForProvider.Backend, meta.GetExternalName(role))
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(path).Return(&api.Secret{Data: data}, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.fields.clientBuilder(t)}
+			e := external{client: tc.fields.clientBuilder(t), logger: logging.NewNopLogger()}
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -187,6 +497,13 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			role, _ := tc.args.mg.(*v1alpha1.Role)
+			if diff := cmp.Diff(tc.want.atProvider, role.Status.AtProvider, cmpopts.IgnoreFields(v1alpha1.RoleObservation{}, "RedirectURIsVerifiedTime")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if verified := role.Status.AtProvider.RedirectURIsVerifiedTime != nil; verified != tc.want.verified {
+				t.Errorf("\n%s\ne.Observe(...): want verified %t, got %t\n", tc.reason, tc.want.verified, verified)
+			}
 		})
 	}
 }
@@ -310,8 +627,9 @@ func TestUpdate(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalUpdate
-		err error
+		o          managed.ExternalUpdate
+		atProvider v1alpha1.RoleObservation
+		err        error
 	}
 
 	cases := map[string]struct {
@@ -360,6 +678,30 @@ func TestUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"verified redirect URIs are cleared": {
+			reason: "the allowed redirect URIs of an updated oidc role must be verified again once it is observed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					role := getTestOIDCRole()
+					path := jwtAuthBackendRolePath(*role.Spec.ForProvider.Backend, meta.GetExternalName(role))
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(path, gomock.Any()).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVerifiedRedirectURIs(getTestOIDCRole()),
+			},
+			want: want{
+				o: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -372,6 +714,10 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			role, _ := tc.args.mg.(*v1alpha1.Role)
+			if diff := cmp.Diff(tc.want.atProvider, role.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
 		})
 	}
 }
@@ -455,6 +801,157 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		role   func(role *v1alpha1.Role)
+		want   error
+	}{
+		"valid jwt role": {
+			reason: "a JWT role with bound audiences and a user claim is valid",
+			role:   func(role *v1alpha1.Role) {},
+		},
+		"jwt role without audiences": {
+			reason: "JWT roles must have bound audiences",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.BoundAudiences = nil
+			},
+			want: errors.New(errValidationBoundAudiences),
+		},
+		"oidc role without redirect uris": {
+			reason: "OIDC roles must have allowed redirect URIs",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.RoleType = pointer.String("oidc")
+			},
+			want: errors.New(errValidationRedirectURIs),
+		},
+		"oidc role with leeway": {
+			reason: "leeways are only applicable for JWT roles",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.RoleType = pointer.String("oidc")
+				role.Spec.ForProvider.AllowedRedirectURIs = []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"}
+				role.Spec.ForProvider.ClockSkewLeeway = pointer.Int(30)
+			},
+			want: errors.New(errValidationClockSkewLeeway),
+		},
+		"jwt role with leeway": {
+			reason: "JWT roles accept leeways",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.ClockSkewLeeway = pointer.Int(30)
+				role.Spec.ForProvider.ExpirationLeeway = pointer.Int(-1)
+			},
+		},
+		"missing user claim": {
+			reason: "a user claim is required",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.UserClaim = nil
+			},
+			want: errors.New(errValidationUserClaim),
+		},
+		"user claim is not a json pointer": {
+			reason: "the user claim must be a JSON pointer when user_claim_json_pointer is set",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.UserClaimJSONPointer = pointer.Bool(true)
+			},
+			want: errors.New(errValidationJSONPointer),
+		},
+		"user claim is a json pointer": {
+			reason: "a JSON pointer user claim is valid when user_claim_json_pointer is set",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.UserClaimJSONPointer = pointer.Bool(true)
+				role.Spec.ForProvider.UserClaim = pointer.String("/user/email")
+			},
+		},
+		"invalid bound claims type": {
+			reason: "bound_claims_type must be string or glob",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.BoundClaimsType = pointer.String("regex")
+			},
+			want: errors.New(errValidationBoundClaimsType),
+		},
+		"invalid token type": {
+			reason: "token_type must be service, batch or default",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.TokenType = pointer.String("default-service")
+			},
+			want: errors.New(errValidationTokenType),
+		},
+		"token ttl greater than max ttl": {
+			reason: "token_ttl cannot exceed token_max_ttl",
+			role: func(role *v1alpha1.Role) {
+				role.Spec.ForProvider.TokenTTL = pointer.Int(7200)
+				role.Spec.ForProvider.TokenMaxTTL = pointer.Int(3600)
+			},
+			want: errors.New(errValidationTokenTTL),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			role := getTestRole()
+			tc.role(role)
+			err := fromCrossplane(role).Validate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	type want struct {
+		boundClaims     map[string]string
+		lateInitialized bool
+	}
+
+	cases := map[string]struct {
+		reason      string
+		boundClaims map[string]interface{}
+		want        want
+	}{
+		"string bound claims": {
+			reason:      "bound claims with string values must be late initialized",
+			boundClaims: map[string]interface{}{"env": "prod"},
+			want: want{
+				boundClaims:     map[string]string{"env": "prod"},
+				lateInitialized: true,
+			},
+		},
+		"list valued bound claim": {
+			reason: "bound claims with a list value must not be late initialized, as a map of strings cannot hold them",
+			boundClaims: map[string]interface{}{
+				"env":    "prod",
+				"groups": []interface{}{"admins", "ops"},
+			},
+			want: want{
+				boundClaims:     nil,
+				lateInitialized: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			role := getTestRole()
+			data := getVaultDefaultData(meta.GetExternalName(role))
+			data["bound_audiences"] = []interface{}{"test"}
+			data["bound_claims"] = tc.boundClaims
+			vaultData, err := fromVault(data)
+			if err != nil {
+				t.Fatalf("\n%s\nfromVault(...): unexpected error: %v\n", tc.reason, err)
+			}
+
+			got := lateInitialize(&role.Spec.ForProvider, vaultData)
+			if diff := cmp.Diff(tc.want.lateInitialized, got); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.boundClaims, role.Spec.ForProvider.BoundClaims); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want bound claims, +got bound claims:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func withExternalName(role *v1alpha1.Role, name string) *v1alpha1.Role {
 	meta.SetExternalName(role, name)
 	return role
@@ -477,6 +974,7 @@ func getTestRole() *v1alpha1.Role {
 				Backend:        pointer.String("gitlab"),
 				RoleType:       pointer.String("jwt"),
 				BoundAudiences: []string{"test"},
+				UserClaim:      pointer.String("user_email"),
 			},
 		},
 	}
@@ -484,6 +982,25 @@ func getTestRole() *v1alpha1.Role {
 	return role
 }
 
+func getTestOIDCRole() *v1alpha1.Role {
+	role := getTestRole()
+	role.Spec.ForProvider.Backend = pointer.String("oidc")
+	role.Spec.ForProvider.RoleType = pointer.String("oidc")
+	role.Spec.ForProvider.AllowedRedirectURIs = []string{
+		"https://vault.example.com/ui/vault/auth/oidc/oidc/callback",
+		"http://localhost:8250/oidc/callback",
+	}
+	return role
+}
+
+func withVerifiedRedirectURIs(role *v1alpha1.Role) *v1alpha1.Role {
+	role.Status.AtProvider.AcceptedRedirectURIs = []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"}
+	role.Status.AtProvider.RejectedRedirectURIs = []string{"http://localhost:8250/oidc/callback"}
+	verified := metav1.Now()
+	role.Status.AtProvider.RedirectURIsVerifiedTime = &verified
+	return role
+}
+
 func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
 	ctrl := gomock.NewController(t)
 	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
@@ -504,7 +1021,7 @@ func getVaultDefaultData(name string) map[string]interface{} {
 		"namespace":               "",
 		"role_type":               "jwt",
 		"bound_audiences":         []interface{}{},
-		"user_claim":              "",
+		"user_claim":              "user_email",
 		"user_claim_json_pointer": false,
 		"bound_subject":           "",
 		"bound_claims":            map[string]interface{}{},
@@ -3,10 +3,12 @@ package role
 import (
 	"encoding/json"
 	"strconv"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/pkg/errors"
 	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
 	"k8s.io/utils/pointer"
 )
 
@@ -44,28 +46,75 @@ type Role struct {
 // Validate validates if a role follow vault constraints
 // Check https://developer.hashicorp.com/vault/api-docs/auth/jwt#create-role to see vault contraints for JWT/OIDC roles
 func (role *Role) Validate() error {
-	if role.RoleType == "jwt" {
-		if role.ClockSkewLeeway != "0" {
+	if err := role.validateRoleType(); err != nil {
+		return err
+	}
+
+	if role.UserClaim == "" {
+		return errors.New(errValidationUserClaim)
+	}
+	if role.UserClaimJSONPointer && !strings.HasPrefix(role.UserClaim, "/") {
+		return errors.New(errValidationJSONPointer)
+	}
+
+	switch role.BoundClaimsType {
+	case "", "string", "glob":
+	default:
+		return errors.New(errValidationBoundClaimsType)
+	}
+
+	switch role.TokenType {
+	case "", "service", "batch", "default":
+	default:
+		return errors.New(errValidationTokenType)
+	}
+
+	ttl, _ := role.TokenTTL.Int64()
+	maxTTL, _ := role.TokenMaxTTL.Int64()
+	if maxTTL > 0 && ttl > maxTTL {
+		return errors.New(errValidationTokenTTL)
+	}
+
+	return nil
+}
+
+// validateRoleType checks the fields that depend on the role type. Vault
+// defaults the type to oidc when it is not set.
+func (role *Role) validateRoleType() error {
+	switch role.RoleType {
+	case "jwt":
+		if len(role.BoundAudiences) == 0 {
+			return errors.New(errValidationBoundAudiences)
+		}
+	case "", "oidc":
+		if len(role.AllowedRedirectURIs) == 0 {
+			return errors.New(errValidationRedirectURIs)
+		}
+		if !isZero(role.ClockSkewLeeway) {
 			return errors.New(errValidationClockSkewLeeway)
 		}
-		if role.NotBeforeLeeway != "0" {
+		if !isZero(role.NotBeforeLeeway) {
 			return errors.New(errValidationNotBeforeLeeway)
 		}
-		if role.ExpirationLeeway != "0" {
+		if !isZero(role.ExpirationLeeway) {
 			return errors.New(errValidationExpirationLeeway)
 		}
+	default:
+		return errors.New(errValidationRoleType)
 	}
 
 	return nil
 }
 
+func isZero(n json.Number) bool {
+	return n == "" || n == "0"
+}
+
 func fromVault(data map[string]interface{}) (*Role, error) {
 	role := Role{}
-	jsonObj, err := json.Marshal(data)
-	if err != nil {
-		return nil, errors.New(errDecodingData)
+	if err := clients.DecodeData(data, &role); err != nil {
+		return nil, errors.Wrap(err, errDecodingData)
 	}
-	_ = json.Unmarshal(jsonObj, &role)
 	return &role, nil
 }
 
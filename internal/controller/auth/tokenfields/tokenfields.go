@@ -0,0 +1,124 @@
+// Package tokenfields maps the token parameters shared by the auth methods
+// that issue Vault tokens to and from the token_* fields Vault uses for them.
+package tokenfields
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	errValidationTokenTTL     = "token_ttl cannot be greater than token_max_ttl"
+	errValidationTokenType    = "token_type default-service and default-batch are only valid for token roles"
+	errValidationTokenRoleTTL = "token roles do not support token_ttl, token_max_ttl and token_policies, use allowedPolicies instead"
+)
+
+// VaultTokenFields is the vault side of v1alpha1.TokenParameters. Auth
+// methods embed it in their helper structs, so that the token fields are
+// decoded and compared along with the fields specific to the method.
+type VaultTokenFields struct {
+	TokenTTL             int      `json:"token_ttl"`
+	TokenMaxTTL          int      `json:"token_max_ttl"`
+	TokenPolicies        []string `json:"token_policies"`
+	TokenBoundCIDRS      []string `json:"token_bound_cidrs"`
+	TokenExplicitMaxTTL  int      `json:"token_explicit_max_ttl"`
+	TokenNoDefaultPolicy bool     `json:"token_no_default_policy"`
+	TokenNumUses         int      `json:"token_num_uses"`
+	TokenPeriod          int      `json:"token_period"`
+	TokenType            string   `json:"token_type"`
+}
+
+// Validate checks the token parameters against vault constraints
+func Validate(params v1alpha1.TokenParameters) error {
+	ttl := pointer.IntDeref(params.TokenTTL, 0)
+	maxTTL := pointer.IntDeref(params.TokenMaxTTL, 0)
+	if maxTTL > 0 && ttl > maxTTL {
+		return errors.New(errValidationTokenTTL)
+	}
+
+	switch pointer.StringDeref(params.TokenType, "") {
+	case "default-service", "default-batch":
+		return errors.New(errValidationTokenType)
+	}
+	return nil
+}
+
+// ValidateTokenRole checks the token parameters of a token role. Token roles
+// accept the default-service and default-batch token types, but take neither
+// token TTLs nor token policies.
+func ValidateTokenRole(params v1alpha1.TokenParameters) error {
+	if pointer.IntDeref(params.TokenTTL, 0) != 0 || pointer.IntDeref(params.TokenMaxTTL, 0) != 0 || len(params.TokenPolicies) > 0 {
+		return errors.New(errValidationTokenRoleTTL)
+	}
+	return nil
+}
+
+// FromCrossplane returns the vault side of the token parameters, with unset
+// parameters as zero values.
+func FromCrossplane(params v1alpha1.TokenParameters) VaultTokenFields {
+	return VaultTokenFields{
+		TokenTTL:             pointer.IntDeref(params.TokenTTL, 0),
+		TokenMaxTTL:          pointer.IntDeref(params.TokenMaxTTL, 0),
+		TokenPolicies:        params.TokenPolicies,
+		TokenBoundCIDRS:      params.TokenBoundCIDRS,
+		TokenExplicitMaxTTL:  pointer.IntDeref(params.TokenExplicitMaxTTL, 0),
+		TokenNoDefaultPolicy: pointer.BoolDeref(params.TokenNoDefaultPolicy, false),
+		TokenNumUses:         pointer.IntDeref(params.TokenNumUses, 0),
+		TokenPeriod:          pointer.IntDeref(params.TokenPeriod, 0),
+		TokenType:            pointer.StringDeref(params.TokenType, ""),
+	}
+}
+
+// Encode adds the token parameters set in the managed resource to the data
+// sent to vault. Unset parameters are left out rather than sent as zero
+// values, so that vault keeps applying the defaults of the mount and of the
+// system to them. The encode functions of the auth method controllers
+// follow the same rule for their own parameters.
+func Encode(params v1alpha1.TokenParameters, data map[string]interface{}) {
+	setInt(data, "token_ttl", params.TokenTTL)
+	setInt(data, "token_max_ttl", params.TokenMaxTTL)
+	setInt(data, "token_explicit_max_ttl", params.TokenExplicitMaxTTL)
+	setInt(data, "token_num_uses", params.TokenNumUses)
+	setInt(data, "token_period", params.TokenPeriod)
+
+	if params.TokenPolicies != nil {
+		data["token_policies"] = params.TokenPolicies
+	}
+	if params.TokenBoundCIDRS != nil {
+		data["token_bound_cidrs"] = params.TokenBoundCIDRS
+	}
+	if params.TokenNoDefaultPolicy != nil {
+		data["token_no_default_policy"] = *params.TokenNoDefaultPolicy
+	}
+	if params.TokenType != nil {
+		data["token_type"] = *params.TokenType
+	}
+}
+
+// LateInitialize fills the token parameters left unset in the managed
+// resource with the values vault holds. It returns true when any parameter
+// was filled.
+func LateInitialize(params *v1alpha1.TokenParameters, vault VaultTokenFields) bool {
+	li := false
+
+	li = clients.LateInitInt(&params.TokenTTL, vault.TokenTTL) || li
+	li = clients.LateInitInt(&params.TokenMaxTTL, vault.TokenMaxTTL) || li
+	li = clients.LateInitStrings(&params.TokenPolicies, vault.TokenPolicies) || li
+	li = clients.LateInitStrings(&params.TokenBoundCIDRS, vault.TokenBoundCIDRS) || li
+	li = clients.LateInitInt(&params.TokenExplicitMaxTTL, vault.TokenExplicitMaxTTL) || li
+	li = clients.LateInitBool(&params.TokenNoDefaultPolicy, vault.TokenNoDefaultPolicy) || li
+	li = clients.LateInitInt(&params.TokenNumUses, vault.TokenNumUses) || li
+	li = clients.LateInitInt(&params.TokenPeriod, vault.TokenPeriod) || li
+	li = clients.LateInitString(&params.TokenType, vault.TokenType) || li
+
+	return li
+}
+
+func setInt(data map[string]interface{}, key string, value *int) {
+	if value != nil {
+		data[key] = *value
+	}
+}
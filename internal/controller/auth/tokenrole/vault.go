@@ -0,0 +1,96 @@
+package tokenrole
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultTokenRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultTokenRole struct {
+	AllowedPolicies        []string `json:"allowed_policies"`
+	DisallowedPolicies     []string `json:"disallowed_policies"`
+	AllowedPoliciesGlob    []string `json:"allowed_policies_glob"`
+	DisallowedPoliciesGlob []string `json:"disallowed_policies_glob"`
+	Orphan                 bool     `json:"orphan"`
+	Renewable              bool     `json:"renewable"`
+	PathSuffix             string   `json:"path_suffix"`
+	AllowedEntityAliases   []string `json:"allowed_entity_aliases"`
+
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.TokenRoleParameters) *VaultTokenRole {
+	return &VaultTokenRole{
+		AllowedPolicies:        params.AllowedPolicies,
+		DisallowedPolicies:     params.DisallowedPolicies,
+		AllowedPoliciesGlob:    params.AllowedPoliciesGlob,
+		DisallowedPoliciesGlob: params.DisallowedPoliciesGlob,
+		Orphan:                 pointer.BoolDeref(params.Orphan, false),
+		Renewable:              pointer.BoolDeref(params.Renewable, false),
+		PathSuffix:             pointer.StringDeref(params.PathSuffix, ""),
+		AllowedEntityAliases:   params.AllowedEntityAliases,
+		VaultTokenFields:       tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultTokenRole, error) {
+	role := &VaultTokenRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role from the parameters set in
+// the managed resource. Token roles do not take token TTLs nor token
+// policies, so these are never sent.
+func encode(params v1alpha1.TokenRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	setStrings(data, "allowed_policies", params.AllowedPolicies)
+	setStrings(data, "disallowed_policies", params.DisallowedPolicies)
+	setStrings(data, "allowed_policies_glob", params.AllowedPoliciesGlob)
+	setStrings(data, "disallowed_policies_glob", params.DisallowedPoliciesGlob)
+	setStrings(data, "allowed_entity_aliases", params.AllowedEntityAliases)
+
+	if params.Orphan != nil {
+		data["orphan"] = *params.Orphan
+	}
+	if params.Renewable != nil {
+		data["renewable"] = *params.Renewable
+	}
+	if params.PathSuffix != nil {
+		data["path_suffix"] = *params.PathSuffix
+	}
+
+	tokenfields.Encode(params.TokenParameters, data)
+	delete(data, "token_ttl")
+	delete(data, "token_max_ttl")
+	delete(data, "token_policies")
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.TokenRoleParameters, vaultData *VaultTokenRole) bool {
+	li := false
+
+	li = clients.LateInitBool(&params.Orphan, vaultData.Orphan) || li
+	li = clients.LateInitBool(&params.Renewable, vaultData.Renewable) || li
+	li = clients.LateInitString(&params.PathSuffix, vaultData.PathSuffix) || li
+	li = tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields) || li
+
+	return li
+}
+
+func setStrings(data map[string]interface{}, key string, value []string) {
+	if value != nil {
+		data[key] = value
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userpassuser
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotUserpassUser   = "managed resource is not a UserpassUser custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errCreation = "cannot create userpass user"
+	errUpdate   = "cannot update userpass user"
+	errDelete   = "cannot delete userpass user"
+	errRead     = "cannot read userpass user"
+	errDecode   = "error decoding userpass user returned by vault"
+	errPassword = "cannot get userpass user password"
+
+	defaultBackend = "userpass"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles UserpassUser managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.UserpassUserGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.UserpassUserGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.UserpassUser{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return nil, errors.New(errNotUserpassUser)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the password from the referenced Secret
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the user, late initializing the token parameters left unset
+// with the values vault holds and comparing only the ones set. Vault does not
+// return the password, so the hash of the one in the Secret is compared with
+// the hash of the last one written instead.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	user, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserpassUser)
+	}
+
+	secret, err := c.client.Logical().Read(userPath(user))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// vault deletes the user by name, so the password Secret, which may be
+	// removed along with the user, is not read
+	if meta.WasDeleted(user) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	password, err := c.password(ctx, user)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	lateInitialized := lateInitialize(&user.Spec.ForProvider, vaultData)
+	upToDate := user.Status.AtProvider.PasswordHash == clients.SecretHash(c.client.Token(), user, password) &&
+		cmp.Equal(*fromCrossplane(user.Spec.ForProvider), *vaultData,
+			cmpopts.EquateEmpty(),
+			clients.IgnoreUnset(user.Spec.ForProvider, VaultUserpassUser{}))
+
+	if upToDate {
+		user.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create a userpass user. The status is not kept by the reconciler after a
+// creation, so the hash of the password is only recorded by the update that
+// follows it.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	user, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserpassUser)
+	}
+
+	if err := c.writeUser(ctx, user); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreation)
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update a userpass user, writing the password again along with the token
+// parameters and recording its hash.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	user, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserpassUser)
+	}
+
+	if err := c.writeUser(ctx, user); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete a userpass user
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	user, ok := mg.(*v1alpha1.UserpassUser)
+	if !ok {
+		return errors.New(errNotUserpassUser)
+	}
+
+	c.logger.Debug("Deleting userpass user", "path", userPath(user))
+	if _, err := c.client.Logical().Delete(userPath(user)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeUser(ctx context.Context, user *v1alpha1.UserpassUser) error {
+	if err := tokenfields.Validate(user.Spec.ForProvider.TokenParameters); err != nil {
+		return err
+	}
+
+	password, err := c.password(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Creating/Updating userpass user", "path", userPath(user))
+	if _, err := c.client.Logical().Write(userPath(user), encode(user.Spec.ForProvider, password)); err != nil {
+		return err
+	}
+
+	user.Status.AtProvider.PasswordHash = clients.SecretHash(c.client.Token(), user, password)
+	return nil
+}
+
+func (c *external) password(ctx context.Context, user *v1alpha1.UserpassUser) ([]byte, error) {
+	ref := user.Spec.ForProvider.PasswordSecretRef
+	password, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: &ref})
+	if err != nil {
+		return nil, errors.Wrap(err, errPassword)
+	}
+	return password, nil
+}
+
+func userPath(user *v1alpha1.UserpassUser) string {
+	backend := pointer.StringDeref(user.Spec.ForProvider.Backend, defaultBackend)
+	return "auth/" + strings.Trim(backend, "/") + "/users/" + meta.GetExternalName(user)
+}
@@ -0,0 +1,47 @@
+package userpassuser
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/auth/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenfields"
+)
+
+// VaultUserpassUser is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultUserpassUser struct {
+	tokenfields.VaultTokenFields
+}
+
+func fromCrossplane(params v1alpha1.UserpassUserParameters) *VaultUserpassUser {
+	return &VaultUserpassUser{
+		VaultTokenFields: tokenfields.FromCrossplane(params.TokenParameters),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultUserpassUser, error) {
+	user := &VaultUserpassUser{}
+	if err := clients.DecodeData(data, user); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return user, nil
+}
+
+// encode builds the body of a write to the user. The password is always
+// sent, since vault cannot return it to be compared, along with the token
+// parameters set in the managed resource.
+func encode(params v1alpha1.UserpassUserParameters, password []byte) map[string]interface{} {
+	data := map[string]interface{}{
+		"password": string(password),
+	}
+	tokenfields.Encode(params.TokenParameters, data)
+
+	return data
+}
+
+// lateInitialize fills the token parameters left unset in the managed
+// resource with the values Vault holds. It returns true when any parameter
+// was filled.
+func lateInitialize(params *v1alpha1.UserpassUserParameters, vaultData *VaultUserpassUser) bool {
+	return tokenfields.LateInitialize(&params.TokenParameters, vaultData.VaultTokenFields)
+}
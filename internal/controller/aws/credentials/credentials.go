@@ -0,0 +1,324 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotCredentials    = "managed resource is not a Credentials custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errIssue   = "cannot issue AWS credentials"
+	errNoCreds = "vault returned no AWS credentials"
+	errLookup  = "cannot lookup AWS credentials lease"
+	errRenew   = "cannot renew AWS credentials lease"
+	errRevoke  = "cannot revoke AWS credentials lease"
+
+	leaseLookupPath = "sys/leases/lookup"
+
+	// vaultErrInvalidLease is the error vault answers a lookup of a lease it
+	// does not know with.
+	vaultErrInvalidLease = "invalid lease"
+)
+
+// Connection details published for a Credentials.
+const (
+	ConnectionKeyAccessKey     = "access_key"
+	ConnectionKeySecretKey     = "secret_key"
+	ConnectionKeySecurityToken = "security_token"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles Credentials managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CredentialsGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CredentialsGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		// The external name is the lease ID, which is only known once the
+		// credentials are issued.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Credentials{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Credentials)
+	if !ok {
+		return nil, errors.New(errNotCredentials)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe looks the lease of the current credentials up. Credentials whose
+// lease is gone, was issued for different parameters or can no longer be
+// renewed are reported as not existing, so that new ones are issued. A lease
+// that is about to expire but can be renewed is reported as not up to date.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Credentials)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCredentials)
+	}
+
+	leaseID := meta.GetExternalName(cr)
+	if leaseID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	lease, err := c.lookupLease(leaseID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errLookup)
+	}
+	if lease == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	expireTime := metav1.NewTime(lease.ExpireTime)
+	cr.Status.AtProvider = v1alpha1.CredentialsObservation{
+		LeaseID:       leaseID,
+		LeaseDuration: int(lease.Duration().Seconds()),
+		Renewable:     lease.Renewable,
+		ExpireTime:    &expireTime,
+	}
+
+	if !strings.HasPrefix(leaseID, credsPath(cr.Spec.ForProvider)+"/") {
+		c.logger.Debug("Credentials were issued for different parameters", "lease", leaseID)
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	renewBefore := lease.Duration() / 3
+	if cr.Spec.ForProvider.RenewBefore > 0 {
+		renewBefore = time.Duration(cr.Spec.ForProvider.RenewBefore) * time.Second
+	}
+
+	if time.Until(lease.ExpireTime) > renewBefore {
+		cr.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if lease.Renewable && lease.RenewalHelps(renewBefore) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	c.logger.Debug("Credentials lease is about to expire and cannot be renewed", "lease", leaseID)
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+// Create issues new credentials and records their lease ID as the external
+// name. The lease of the credentials they supersede is revoked once the new
+// ones are issued. Failing to revoke it is only logged, since the new
+// credentials must be published anyway and the old lease expires on its own.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Credentials)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCredentials)
+	}
+
+	supersededLeaseID := meta.GetExternalName(cr)
+
+	secret, err := c.client.Logical().Write(credsPath(cr.Spec.ForProvider), issueData(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errIssue)
+	}
+	if secret == nil || secret.LeaseID == "" {
+		return managed.ExternalCreation{}, errors.New(errNoCreds)
+	}
+
+	creds, err := credsFromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errIssue)
+	}
+
+	meta.SetExternalName(cr, secret.LeaseID)
+
+	if supersededLeaseID != "" {
+		c.logger.Debug("Revoking superseded credentials lease", "lease", supersededLeaseID)
+		if err := c.client.Sys().Revoke(supersededLeaseID); err != nil {
+			c.logger.Debug("Cannot revoke superseded credentials lease", "lease", supersededLeaseID, "error", err)
+		}
+	}
+
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			ConnectionKeyAccessKey:     []byte(creds.AccessKey),
+			ConnectionKeySecretKey:     []byte(creds.SecretKey),
+			ConnectionKeySecurityToken: []byte(creds.SecurityToken),
+		},
+	}, nil
+}
+
+// Update renews the lease of the current credentials
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Credentials)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCredentials)
+	}
+
+	leaseID := meta.GetExternalName(cr)
+	c.logger.Debug("Renewing credentials lease", "lease", leaseID)
+	if _, err := c.client.Sys().Renew(leaseID, cr.Spec.ForProvider.TTL); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRenew)
+	}
+
+	return managed.ExternalUpdate{
+		// Renewing a lease does not change the credentials.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete revokes the lease of the current credentials
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Credentials)
+	if !ok {
+		return errors.New(errNotCredentials)
+	}
+
+	leaseID := meta.GetExternalName(cr)
+	if leaseID == "" {
+		return nil
+	}
+
+	c.logger.Debug("Revoking credentials lease", "lease", leaseID)
+	if err := c.client.Sys().Revoke(leaseID); err != nil {
+		return errors.Wrap(err, errRevoke)
+	}
+
+	return nil
+}
+
+// lookupLease returns nil when Vault does not know the lease anymore
+func (c *external) lookupLease(leaseID string) (*VaultLease, error) {
+	secret, err := c.client.Logical().Write(leaseLookupPath, map[string]interface{}{"lease_id": leaseID})
+	if err != nil {
+		if isInvalidLease(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if secret == nil {
+		return nil, nil
+	}
+
+	return leaseFromVault(secret.Data)
+}
+
+// isInvalidLease tells whether vault rejected a lease lookup because it does
+// not know the lease. Other bad requests, such as a malformed lease ID or a
+// policy error, are not taken as the lease being gone.
+func isInvalidLease(err error) bool {
+	var respErr *vault.ResponseError
+	if !errors.As(err, &respErr) || respErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, e := range respErr.Errors {
+		if e == vaultErrInvalidLease {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,651 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const testLeaseID = "aws/sts/deploy/abcdef"
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"not issued yet": {
+			reason: "credentials without a lease ID must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(""),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"lease is gone": {
+			reason: "credentials whose lease vault does not know must not exist",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, map[string]interface{}{"lease_id": testLeaseID}).
+						Return(nil, &api.ResponseError{StatusCode: http.StatusBadRequest, Errors: []string{"invalid lease"}})
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"lookup is a bad request": {
+			reason: "bad requests other than an invalid lease must be returned, not taken as the lease being gone",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).Return(nil, badRequestError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(badRequestError(), errLookup),
+			},
+		},
+		"lookup fails": {
+			reason: "unexpected lookup errors must be returned",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(vaultMockError(), errLookup),
+			},
+		},
+		"lease is fresh": {
+			reason: "credentials far from expiring must be up to date",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).
+						Return(leaseSecret(time.Now().Add(-10*time.Minute), time.Now().Add(50*time.Minute), nil, false), nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"renewable lease about to expire": {
+			reason: "a renewable lease inside the renewal window must be renewed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).
+						Return(leaseSecret(time.Now().Add(-50*time.Minute), time.Now().Add(10*time.Minute), nil, true), nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"renewable lease at its max TTL": {
+			reason: "a lease whose last renewal did not leave the renewal window must be re-issued",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					lastRenewal := time.Now().Add(-1 * time.Minute)
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).
+						Return(leaseSecret(time.Now().Add(-50*time.Minute), time.Now().Add(10*time.Minute), &lastRenewal, true), nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"non renewable lease about to expire": {
+			reason: "a non renewable lease inside the renewal window must be re-issued",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).
+						Return(leaseSecret(time.Now().Add(-50*time.Minute), time.Now().Add(10*time.Minute), nil, false), nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"parameters changed": {
+			reason: "credentials issued from another role must be re-issued",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write(leaseLookupPath, gomock.Any()).
+						Return(leaseSecret(time.Now().Add(-10*time.Minute), time.Now().Add(50*time.Minute), nil, false), nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials("aws/sts/other/abcdef"),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  *v1alpha1.Credentials
+	}
+
+	type want struct {
+		o            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully issue": {
+			reason: "credentials must be issued and their lease recorded as external name",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"ttl":      3600,
+						"role_arn": "arn:aws:iam::123456789012:role/deploy",
+					}
+
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write("aws/sts/deploy", data).Return(&api.Secret{
+						LeaseID: testLeaseID,
+						Data: map[string]interface{}{
+							"access_key":     "ASIAEXAMPLE",
+							"secret_key":     "secret",
+							"security_token": "token",
+						},
+					}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(""),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionKeyAccessKey:     []byte("ASIAEXAMPLE"),
+						ConnectionKeySecretKey:     []byte("secret"),
+						ConnectionKeySecurityToken: []byte("token"),
+					},
+				},
+				externalName: testLeaseID,
+			},
+		},
+		"re-issue": {
+			reason: "the lease of the superseded credentials must be revoked once new ones are issued",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, sysMock := newMock(t)
+					gomock.InOrder(
+						logicalMock.EXPECT().Write("aws/sts/deploy", gomock.Any()).Return(&api.Secret{
+							LeaseID: testLeaseID + "2",
+							Data: map[string]interface{}{
+								"access_key":     "ASIAEXAMPLE2",
+								"secret_key":     "secret2",
+								"security_token": "token2",
+							},
+						}, nil),
+						sysMock.EXPECT().Revoke(testLeaseID).Return(nil),
+					)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionKeyAccessKey:     []byte("ASIAEXAMPLE2"),
+						ConnectionKeySecretKey:     []byte("secret2"),
+						ConnectionKeySecurityToken: []byte("token2"),
+					},
+				},
+				externalName: testLeaseID + "2",
+			},
+		},
+		"fail revoking superseded": {
+			reason: "new credentials must be published even if the superseded lease cannot be revoked",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, sysMock := newMock(t)
+					logicalMock.EXPECT().Write("aws/sts/deploy", gomock.Any()).Return(&api.Secret{
+						LeaseID: testLeaseID + "2",
+						Data: map[string]interface{}{
+							"access_key":     "ASIAEXAMPLE2",
+							"secret_key":     "secret2",
+							"security_token": "token2",
+						},
+					}, nil)
+					sysMock.EXPECT().Revoke(testLeaseID).Return(vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionKeyAccessKey:     []byte("ASIAEXAMPLE2"),
+						ConnectionKeySecretKey:     []byte("secret2"),
+						ConnectionKeySecurityToken: []byte("token2"),
+					},
+				},
+				externalName: testLeaseID + "2",
+			},
+		},
+		"fail issuing superseding": {
+			reason: "the current lease must be kept when new credentials cannot be issued",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write("aws/sts/deploy", gomock.Any()).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o:            managed.ExternalCreation{},
+				externalName: testLeaseID,
+				err:          errors.Wrap(vaultMockError(), errIssue),
+			},
+		},
+		"fail issuing": {
+			reason: "vault errors must be returned",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock, _ := newMock(t)
+					logicalMock.EXPECT().Write("aws/sts/deploy", gomock.Any()).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(""),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(vaultMockError(), errIssue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully renew": {
+			reason: "the lease must be renewed by the configured TTL",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _, sysMock := newMock(t)
+					sysMock.EXPECT().Renew(testLeaseID, 3600).Return(&api.Secret{LeaseID: testLeaseID}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"fail renewing": {
+			reason: "vault errors must be returned",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _, sysMock := newMock(t)
+					sysMock.EXPECT().Renew(testLeaseID, 3600).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.Wrap(vaultMockError(), errRenew),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Update(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully revoke": {
+			reason: "the lease must be revoked",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _, sysMock := newMock(t)
+					sysMock.EXPECT().Revoke(testLeaseID).Return(nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{},
+		},
+		"fail revoking": {
+			reason: "vault errors must be returned",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _, sysMock := newMock(t)
+					sysMock.EXPECT().Revoke(testLeaseID).Return(vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestCredentials(testLeaseID),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRevoke),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestCredentials(leaseID string) *v1alpha1.Credentials {
+	cr := &v1alpha1.Credentials{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.CredentialsKind,
+			APIVersion: v1alpha1.CredentialsKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "deploy-creds",
+		},
+		Spec: v1alpha1.CredentialsSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				DeletionPolicy: "Delete",
+			},
+			ForProvider: v1alpha1.CredentialsParameters{
+				Backend:  "aws",
+				Role:     "deploy",
+				Endpoint: "sts",
+				TTL:      3600,
+				RoleArn:  "arn:aws:iam::123456789012:role/deploy",
+			},
+		},
+	}
+	if leaseID != "" {
+		meta.SetExternalName(cr, leaseID)
+	}
+	return cr
+}
+
+func leaseSecret(issued, expires time.Time, lastRenewal *time.Time, renewable bool) *api.Secret {
+	data := map[string]interface{}{
+		"id":           testLeaseID,
+		"issue_time":   issued.Format(time.RFC3339Nano),
+		"expire_time":  expires.Format(time.RFC3339Nano),
+		"last_renewal": nil,
+		"renewable":    renewable,
+		"ttl":          json.Number("600"),
+	}
+	if lastRenewal != nil {
+		data["last_renewal"] = lastRenewal.Format(time.RFC3339Nano)
+	}
+	return &api.Secret{Data: data}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient, *fake.MockVaultSysClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+	sysMock := fake.NewMockVaultSysClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+	clientMock.EXPECT().Sys().Return(sysMock).AnyTimes()
+
+	return clientMock, logicalMock, sysMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
+
+func badRequestError() error {
+	return &api.ResponseError{StatusCode: http.StatusBadRequest, Errors: []string{"permission denied"}}
+}
@@ -0,0 +1,85 @@
+package credentials
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	errDecode = "error decoding credentials returned by vault"
+)
+
+// VaultCredentials is the AWS access key pair issued by Vault
+type VaultCredentials struct {
+	AccessKey     string `json:"access_key"`
+	SecretKey     string `json:"secret_key"`
+	SecurityToken string `json:"security_token"`
+}
+
+// VaultLease is the response of the sys/leases/lookup endpoint
+type VaultLease struct {
+	ID          string     `json:"id"`
+	IssueTime   time.Time  `json:"issue_time"`
+	ExpireTime  time.Time  `json:"expire_time"`
+	LastRenewal *time.Time `json:"last_renewal"`
+	Renewable   bool       `json:"renewable"`
+	TTL         int        `json:"ttl"`
+}
+
+// Duration is the total lifetime of the lease, renewals included
+func (l *VaultLease) Duration() time.Duration {
+	return l.ExpireTime.Sub(l.IssueTime)
+}
+
+// RenewalHelps tells whether renewing the lease again would move its expiry
+// out of the renewal window. It does not once the lease reaches its max TTL,
+// as Vault then caps every renewal to the same expire time.
+func (l *VaultLease) RenewalHelps(renewBefore time.Duration) bool {
+	if l.LastRenewal == nil {
+		return true
+	}
+	return l.ExpireTime.Sub(*l.LastRenewal) > renewBefore
+}
+
+func credsFromVault(data map[string]interface{}) (*VaultCredentials, error) {
+	creds := &VaultCredentials{}
+	if err := clients.DecodeData(data, creds); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return creds, nil
+}
+
+func leaseFromVault(data map[string]interface{}) (*VaultLease, error) {
+	lease := &VaultLease{}
+	if err := clients.DecodeData(data, lease); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return lease, nil
+}
+
+// issueData is the request sent to Vault to generate credentials
+func issueData(p v1alpha1.CredentialsParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+	if p.TTL > 0 {
+		data["ttl"] = p.TTL
+	}
+	if p.RoleArn != "" {
+		data["role_arn"] = p.RoleArn
+	}
+	return data
+}
+
+// credsPath is the path credentials are generated from, which Vault also
+// uses as the prefix of their lease ID
+func credsPath(p v1alpha1.CredentialsParameters) string {
+	endpoint := p.Endpoint
+	if endpoint == "" {
+		endpoint = "creds"
+	}
+	return strings.Trim(p.Backend, "/") + "/" + endpoint + "/" + p.Role
+}
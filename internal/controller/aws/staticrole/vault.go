@@ -0,0 +1,58 @@
+package staticrole
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	errDecode = "error decoding static role returned by vault"
+)
+
+// VaultStaticRole is a transport object to send to vault, as vault only accepts values as snake_case
+type VaultStaticRole struct {
+	// Username - The name of the IAM user whose access keys are managed by Vault.
+	Username string `json:"username"`
+
+	// RotationPeriod - How often, in seconds, Vault rotates the access key.
+	RotationPeriod int `json:"rotation_period"`
+}
+
+// VaultStaticCreds is the access key pair Vault currently holds for a static role
+type VaultStaticCreds struct {
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+func fromCrossplane(role *v1alpha1.StaticRole) *VaultStaticRole {
+	return &VaultStaticRole{
+		Username:       role.Spec.ForProvider.Username,
+		RotationPeriod: role.Spec.ForProvider.RotationPeriod,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultStaticRole, error) {
+	role := &VaultStaticRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+func credsFromVault(data map[string]interface{}) (*VaultStaticCreds, error) {
+	creds := &VaultStaticCreds{}
+	if err := clients.DecodeData(data, creds); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return creds, nil
+}
+
+// encode prepares the transport object to be sent to Vault as vault only accepts interface
+func encode(role *VaultStaticRole) map[string]interface{} {
+	return map[string]interface{}{
+		"username":        role.Username,
+		"rotation_period": role.RotationPeriod,
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotConnection     = "managed resource is not a Connection custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errRead       = "cannot read database connection"
+	errWrite      = "cannot write database connection"
+	errDelete     = "cannot delete database connection"
+	errDecode     = "error decoding database connection returned by vault"
+	errRotateRoot = "cannot rotate the root credentials of database connection"
+	errSecretRef  = "cannot get database connection credentials"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles Connection managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ConnectionGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ConnectionGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Connection{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Connection)
+	if !ok {
+		return nil, errors.New(errNotConnection)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the credentials of the connection from the referenced Secrets
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the connection and compares only the parameters set in the
+// managed resource, leaving out the username when it is not set. Vault does
+// not return the password, so the hash of the one in the Secret is compared
+// with the hash of the last one written instead, unless the root credentials
+// are rotated, in which case the connection is outdated until the rotation
+// succeeds. verify_connection is never compared, as vault does not return it.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Connection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConnection)
+	}
+
+	secret, err := c.client.Logical().Read(connectionPath(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	// a Connection being deleted is only removed from vault, which needs
+	// neither its credentials nor a valid configuration
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// an invalid connection is reported as outdated, so that Update surfaces
+	// the validation error without blocking the deletion
+	if err := cr.Validate(); err != nil {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	params := cr.Spec.ForProvider
+	_, creds, block := plugin(params)
+	username, err := c.extractSecret(ctx, creds.UsernameSecretRef)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		clients.IgnoreUnset(params, VaultConnection{}),
+		clients.IgnoreUnset(block, VaultConnectionDetails{}),
+	}
+	if username == nil {
+		opts = append(opts, cmpopts.IgnoreFields(VaultConnectionDetails{}, "Username"))
+	}
+	upToDate := cmp.Equal(*fromCrossplane(params, pointer.StringDeref(username, "")), *vaultData, opts...)
+
+	if pointer.BoolDeref(params.RotateRoot, false) {
+		upToDate = upToDate && cr.Status.AtProvider.RootRotated
+	} else {
+		password, err := c.extractSecret(ctx, creds.PasswordSecretRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		hash := c.passwordHash(cr, password)
+		// once vault rotated the root credentials the password of the Secret
+		// is stale, so it is taken as written rather than sent over the
+		// rotated one, and only its next change is
+		if cr.Status.AtProvider.RootRotated {
+			cr.Status.AtProvider.RootRotated = false
+			cr.Status.AtProvider.PasswordHash = hash
+		}
+		upToDate = upToDate && cr.Status.AtProvider.PasswordHash == hash
+	}
+
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the connection along with its password. The root credentials
+// are rotated by Update, which records the rotation in the status, so that a
+// failed rotation is retried.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Connection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConnection)
+	}
+
+	if err := c.writeConnection(ctx, cr, true); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the connection, then rotates its root credentials when asked
+// to and they were not rotated yet. Vault keeps the password it holds when none
+// is written, which the root credentials rely on, whether rotated or about to
+// be.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Connection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConnection)
+	}
+
+	rotateRoot := pointer.BoolDeref(cr.Spec.ForProvider.RotateRoot, false)
+	if err := c.writeConnection(ctx, cr, !rotateRoot); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if rotateRoot && !cr.Status.AtProvider.RootRotated {
+		c.logger.Debug("Rotating database root credentials", "path", rotateRootPath(cr))
+		if _, err := c.client.Logical().Write(rotateRootPath(cr), nil); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRotateRoot)
+		}
+		cr.Status.AtProvider.RootRotated = true
+		cr.Status.AtProvider.PasswordHash = ""
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete deletes the connection
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Connection)
+	if !ok {
+		return errors.New(errNotConnection)
+	}
+
+	c.logger.Debug("Deleting database connection", "path", connectionPath(cr))
+	if _, err := c.client.Logical().Delete(connectionPath(cr)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeConnection(ctx context.Context, cr *v1alpha1.Connection, withPassword bool) error {
+	if err := cr.Validate(); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	params := cr.Spec.ForProvider
+	_, creds, _ := plugin(params)
+	username, err := c.extractSecret(ctx, creds.UsernameSecretRef)
+	if err != nil {
+		return err
+	}
+	var password *string
+	if withPassword {
+		if password, err = c.extractSecret(ctx, creds.PasswordSecretRef); err != nil {
+			return err
+		}
+	}
+
+	c.logger.Debug("Writing database connection", "path", connectionPath(cr))
+	if _, err := c.client.Logical().Write(connectionPath(cr), encode(params, username, password)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	if withPassword {
+		cr.Status.AtProvider.PasswordHash = c.passwordHash(cr, password)
+	}
+	return nil
+}
+
+// passwordHash returns the keyed hash of the password, or an empty string when
+// there is none
+func (c *external) passwordHash(cr *v1alpha1.Connection, password *string) string {
+	if password == nil {
+		return ""
+	}
+	return clients.SecretHash(c.client.Token(), cr, []byte(*password))
+}
+
+// extractSecret reads the value of the referenced Secret key, returning nil
+// when there is no reference
+func (c *external) extractSecret(ctx context.Context, ref *xpv1.SecretKeySelector) (*string, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	value, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+	if err != nil {
+		return nil, errors.Wrap(err, errSecretRef)
+	}
+	return pointer.String(string(value)), nil
+}
+
+func connectionPath(cr *v1alpha1.Connection) string {
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/config/" + meta.GetExternalName(cr)
+}
+
+func rotateRootPath(cr *v1alpha1.Connection) string {
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/rotate-root/" + meta.GetExternalName(cr)
+}
@@ -0,0 +1,177 @@
+package connection
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	pluginPostgreSQL = "postgresql-database-plugin"
+	pluginMySQL      = "mysql-database-plugin"
+	pluginMongoDB    = "mongodb-database-plugin"
+	pluginRedis      = "redis-database-plugin"
+)
+
+// VaultConnection is an helper struct to compare the connection of the
+// crossplane resource with the one vault holds. verify_connection is not part
+// of it as it only matters when the connection is written.
+type VaultConnection struct {
+	PluginName        string                 `json:"plugin_name"`
+	AllowedRoles      []string               `json:"allowed_roles"`
+	PasswordPolicy    string                 `json:"password_policy"`
+	ConnectionDetails VaultConnectionDetails `json:"connection_details"`
+}
+
+// VaultConnectionDetails holds the connection_details of every plugin the
+// Connection supports, as each plugin returns only its own. The password is
+// not part of it as vault never returns it.
+type VaultConnectionDetails struct {
+	ConnectionURL         string `json:"connection_url"`
+	Username              string `json:"username"`
+	MaxOpenConnections    int    `json:"max_open_connections"`
+	MaxIdleConnections    int    `json:"max_idle_connections"`
+	MaxConnectionLifetime int    `json:"max_connection_lifetime"`
+	UsernameTemplate      string `json:"username_template"`
+	WriteConcern          string `json:"write_concern"`
+	Host                  string `json:"host"`
+	Port                  int    `json:"port"`
+	TLS                   bool   `json:"tls"`
+	InsecureTLS           bool   `json:"insecure_tls"`
+	CACert                string `json:"ca_cert"`
+}
+
+// plugin returns the name of the plugin of the connection, the credentials
+// vault connects with and the block of parameters specific to the plugin.
+// Validate guarantees exactly one block is set.
+func plugin(params v1alpha1.ConnectionParameters) (string, v1alpha1.Credentials, interface{}) {
+	switch {
+	case params.PostgreSQL != nil:
+		return pluginPostgreSQL, params.PostgreSQL.Credentials, *params.PostgreSQL
+	case params.MySQL != nil:
+		return pointer.StringDeref(params.MySQL.PluginName, pluginMySQL), params.MySQL.Credentials, *params.MySQL
+	case params.MongoDB != nil:
+		return pluginMongoDB, params.MongoDB.Credentials, *params.MongoDB
+	default:
+		return pluginRedis, params.Redis.Credentials, *params.Redis
+	}
+}
+
+func fromCrossplane(params v1alpha1.ConnectionParameters, username string) *VaultConnection {
+	name, _, _ := plugin(params)
+	conn := &VaultConnection{
+		PluginName:     name,
+		AllowedRoles:   params.AllowedRoles,
+		PasswordPolicy: pointer.StringDeref(params.PasswordPolicy, ""),
+	}
+
+	details := &conn.ConnectionDetails
+	details.Username = username
+	switch {
+	case params.PostgreSQL != nil:
+		sqlFromCrossplane(*params.PostgreSQL, details)
+	case params.MySQL != nil:
+		sqlFromCrossplane(params.MySQL.SQLConnection, details)
+	case params.MongoDB != nil:
+		details.ConnectionURL = params.MongoDB.ConnectionURL
+		details.WriteConcern = pointer.StringDeref(params.MongoDB.WriteConcern, "")
+		details.UsernameTemplate = pointer.StringDeref(params.MongoDB.UsernameTemplate, "")
+	case params.Redis != nil:
+		details.Host = params.Redis.Host
+		details.Port = params.Redis.Port
+		details.TLS = pointer.BoolDeref(params.Redis.TLS, false)
+		details.InsecureTLS = pointer.BoolDeref(params.Redis.InsecureTLS, false)
+		details.CACert = pointer.StringDeref(params.Redis.CACert, "")
+	}
+	return conn
+}
+
+func sqlFromCrossplane(params v1alpha1.SQLConnection, details *VaultConnectionDetails) {
+	details.ConnectionURL = params.ConnectionURL
+	details.MaxOpenConnections = pointer.IntDeref(params.MaxOpenConnections, 0)
+	details.MaxIdleConnections = pointer.IntDeref(params.MaxIdleConnections, 0)
+	details.MaxConnectionLifetime = pointer.IntDeref(params.MaxConnectionLifetime, 0)
+	details.UsernameTemplate = pointer.StringDeref(params.UsernameTemplate, "")
+}
+
+func fromVault(data map[string]interface{}) (*VaultConnection, error) {
+	conn := &VaultConnection{}
+	if err := clients.DecodeData(data, conn); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return conn, nil
+}
+
+// encode prepares the data to be sent to vault, which takes the connection
+// details at the top level along with the other fields. The username and
+// password are left out when nil, so that vault keeps the ones it has, such
+// as a root password it rotated.
+func encode(params v1alpha1.ConnectionParameters, username, password *string) map[string]interface{} {
+	name, _, _ := plugin(params)
+	data := map[string]interface{}{
+		"plugin_name": name,
+	}
+
+	if params.AllowedRoles != nil {
+		data["allowed_roles"] = params.AllowedRoles
+	}
+	if params.VerifyConnection != nil {
+		data["verify_connection"] = *params.VerifyConnection
+	}
+	if params.PasswordPolicy != nil {
+		data["password_policy"] = *params.PasswordPolicy
+	}
+	if username != nil {
+		data["username"] = *username
+	}
+	if password != nil {
+		data["password"] = *password
+	}
+
+	switch {
+	case params.PostgreSQL != nil:
+		encodeSQL(*params.PostgreSQL, data)
+	case params.MySQL != nil:
+		encodeSQL(params.MySQL.SQLConnection, data)
+	case params.MongoDB != nil:
+		data["connection_url"] = params.MongoDB.ConnectionURL
+		if params.MongoDB.WriteConcern != nil {
+			data["write_concern"] = *params.MongoDB.WriteConcern
+		}
+		if params.MongoDB.UsernameTemplate != nil {
+			data["username_template"] = *params.MongoDB.UsernameTemplate
+		}
+	case params.Redis != nil:
+		data["host"] = params.Redis.Host
+		data["port"] = params.Redis.Port
+		if params.Redis.TLS != nil {
+			data["tls"] = *params.Redis.TLS
+		}
+		if params.Redis.InsecureTLS != nil {
+			data["insecure_tls"] = *params.Redis.InsecureTLS
+		}
+		if params.Redis.CACert != nil {
+			data["ca_cert"] = *params.Redis.CACert
+		}
+	}
+
+	return data
+}
+
+func encodeSQL(params v1alpha1.SQLConnection, data map[string]interface{}) {
+	data["connection_url"] = params.ConnectionURL
+	if params.MaxOpenConnections != nil {
+		data["max_open_connections"] = *params.MaxOpenConnections
+	}
+	if params.MaxIdleConnections != nil {
+		data["max_idle_connections"] = *params.MaxIdleConnections
+	}
+	if params.MaxConnectionLifetime != nil {
+		data["max_connection_lifetime"] = *params.MaxConnectionLifetime
+	}
+	if params.UsernameTemplate != nil {
+		data["username_template"] = *params.UsernameTemplate
+	}
+}
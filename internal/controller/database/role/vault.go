@@ -0,0 +1,93 @@
+package role
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultRole struct {
+	DBName               string            `json:"db_name"`
+	CreationStatements   []string          `json:"creation_statements"`
+	RevocationStatements []string          `json:"revocation_statements"`
+	RollbackStatements   []string          `json:"rollback_statements"`
+	RenewStatements      []string          `json:"renew_statements"`
+	DefaultTTL           int               `json:"default_ttl"`
+	MaxTTL               int               `json:"max_ttl"`
+	CredentialType       string            `json:"credential_type"`
+	CredentialConfig     map[string]string `json:"credential_config"`
+}
+
+func fromCrossplane(params v1alpha1.RoleParameters) *VaultRole {
+	return &VaultRole{
+		DBName:               params.DBName,
+		CreationStatements:   params.CreationStatements,
+		RevocationStatements: params.RevocationStatements,
+		RollbackStatements:   params.RollbackStatements,
+		RenewStatements:      params.RenewStatements,
+		DefaultTTL:           pointer.IntDeref(params.DefaultTTL, 0),
+		MaxTTL:               pointer.IntDeref(params.MaxTTL, 0),
+		CredentialType:       pointer.StringDeref(params.CredentialType, ""),
+		CredentialConfig:     params.CredentialConfig,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultRole, error) {
+	role := &VaultRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+// encode builds the body of a write to the role. The statements are sent as
+// lists, and the credential config as a map, only when set in the managed
+// resource.
+func encode(params v1alpha1.RoleParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"db_name": params.DBName,
+	}
+
+	if params.CreationStatements != nil {
+		data["creation_statements"] = params.CreationStatements
+	}
+	if params.RevocationStatements != nil {
+		data["revocation_statements"] = params.RevocationStatements
+	}
+	if params.RollbackStatements != nil {
+		data["rollback_statements"] = params.RollbackStatements
+	}
+	if params.RenewStatements != nil {
+		data["renew_statements"] = params.RenewStatements
+	}
+	if params.DefaultTTL != nil {
+		data["default_ttl"] = *params.DefaultTTL
+	}
+	if params.MaxTTL != nil {
+		data["max_ttl"] = *params.MaxTTL
+	}
+	if params.CredentialType != nil {
+		data["credential_type"] = *params.CredentialType
+	}
+	if params.CredentialConfig != nil {
+		data["credential_config"] = params.CredentialConfig
+	}
+
+	return data
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.RoleParameters, vaultData *VaultRole) bool {
+	li := false
+
+	li = clients.LateInitInt(&params.DefaultTTL, vaultData.DefaultTTL) || li
+	li = clients.LateInitInt(&params.MaxTTL, vaultData.MaxTTL) || li
+	li = clients.LateInitString(&params.CredentialType, vaultData.CredentialType) || li
+
+	return li
+}
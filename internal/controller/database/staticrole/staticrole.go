@@ -0,0 +1,265 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package staticrole
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotStaticRole     = "managed resource is not a StaticRole custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errCreation  = "cannot create database static role"
+	errUpdate    = "cannot update database static role"
+	errDelete    = "cannot delete database static role"
+	errRead      = "cannot read database static role"
+	errReadCreds = "cannot read database static role credentials"
+)
+
+// Connection details published for a StaticRole.
+const (
+	ConnectionKeyUsername      = "username"
+	ConnectionKeyPassword      = "password"
+	ConnectionKeyRSAPrivateKey = "rsa_private_key"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles StaticRole managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.StaticRoleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.StaticRoleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.StaticRole{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.StaticRole)
+	if !ok {
+		return nil, errors.New(errNotStaticRole)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe reads the static role and, once it exists, the credentials vault
+// currently holds for its database user.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	role, ok := mg.(*v1alpha1.StaticRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotStaticRole)
+	}
+
+	secret, err := c.client.Logical().Read(staticRolePath(role))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+	upToDate := cmp.Equal(*fromCrossplane(role.Spec.ForProvider), *vaultData,
+		cmpopts.EquateEmpty(),
+		clients.IgnoreUnset(role.Spec.ForProvider, VaultStaticRole{}))
+
+	credsSecret, err := c.client.Logical().Read(staticCredsPath(role))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errReadCreds)
+	}
+
+	details := managed.ConnectionDetails{}
+	if credsSecret != nil {
+		creds, err := credsFromVault(credsSecret.Data)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errReadCreds)
+		}
+		details[ConnectionKeyUsername] = []byte(creds.Username)
+		if creds.Password != "" {
+			details[ConnectionKeyPassword] = []byte(creds.Password)
+		}
+		if creds.RSAPrivateKey != "" {
+			details[ConnectionKeyRSAPrivateKey] = []byte(creds.RSAPrivateKey)
+		}
+		role.Status.AtProvider.LastVaultRotation = creds.LastVaultRotation
+	}
+
+	if upToDate {
+		role.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: details,
+	}, nil
+}
+
+// Create a database static role
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	role, ok := mg.(*v1alpha1.StaticRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotStaticRole)
+	}
+
+	if err := c.writeStaticRole(role); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreation)
+	}
+
+	return managed.ExternalCreation{
+		// The credentials are published by the next observation, once vault
+		// has rotated them.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update a database static role. Vault does not rotate the password on an
+// update, only when the rotation period or schedule comes due.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	role, ok := mg.(*v1alpha1.StaticRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotStaticRole)
+	}
+
+	if err := c.writeStaticRole(role); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete a database static role. Vault leaves the database user in place.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	role, ok := mg.(*v1alpha1.StaticRole)
+	if !ok {
+		return errors.New(errNotStaticRole)
+	}
+
+	c.logger.Debug("Deleting database static role", "path", staticRolePath(role))
+	if _, err := c.client.Logical().Delete(staticRolePath(role)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+func (c *external) writeStaticRole(role *v1alpha1.StaticRole) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	c.logger.Debug("Creating/Updating database static role", "path", staticRolePath(role))
+	_, err := c.client.Logical().Write(staticRolePath(role), encode(role.Spec.ForProvider))
+	return err
+}
+
+func staticRolePath(role *v1alpha1.StaticRole) string {
+	return strings.Trim(role.Spec.ForProvider.Mount, "/") + "/static-roles/" + meta.GetExternalName(role)
+}
+
+func staticCredsPath(role *v1alpha1.StaticRole) string {
+	return strings.Trim(role.Spec.ForProvider.Mount, "/") + "/static-creds/" + meta.GetExternalName(role)
+}
@@ -0,0 +1,95 @@
+package staticrole
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/database/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+const (
+	errDecode = "error decoding database static role returned by vault"
+)
+
+// VaultStaticRole is an helper struct to compare the data from the crossplane resource and with data from vault
+type VaultStaticRole struct {
+	DBName             string            `json:"db_name"`
+	Username           string            `json:"username"`
+	RotationPeriod     int               `json:"rotation_period"`
+	RotationSchedule   string            `json:"rotation_schedule"`
+	RotationWindow     int               `json:"rotation_window"`
+	RotationStatements []string          `json:"rotation_statements"`
+	CredentialType     string            `json:"credential_type"`
+	CredentialConfig   map[string]string `json:"credential_config"`
+}
+
+// VaultStaticCreds are the credentials vault currently holds for a static
+// role. Only one of the password and the RSA private key is set, depending on
+// the credential type of the role.
+type VaultStaticCreds struct {
+	Username          string `json:"username"`
+	Password          string `json:"password"`
+	RSAPrivateKey     string `json:"rsa_private_key"`
+	LastVaultRotation string `json:"last_vault_rotation"`
+}
+
+func fromCrossplane(params v1alpha1.StaticRoleParameters) *VaultStaticRole {
+	return &VaultStaticRole{
+		DBName:             params.DBName,
+		Username:           params.Username,
+		RotationPeriod:     pointer.IntDeref(params.RotationPeriod, 0),
+		RotationSchedule:   pointer.StringDeref(params.RotationSchedule, ""),
+		RotationWindow:     pointer.IntDeref(params.RotationWindow, 0),
+		RotationStatements: params.RotationStatements,
+		CredentialType:     pointer.StringDeref(params.CredentialType, ""),
+		CredentialConfig:   params.CredentialConfig,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultStaticRole, error) {
+	role := &VaultStaticRole{}
+	if err := clients.DecodeData(data, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return role, nil
+}
+
+func credsFromVault(data map[string]interface{}) (*VaultStaticCreds, error) {
+	creds := &VaultStaticCreds{}
+	if err := clients.DecodeData(data, creds); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return creds, nil
+}
+
+// encode builds the body of a write to the static role. At most one of the
+// rotation period and schedule is expected to be set, since vault rejects
+// requests carrying both.
+func encode(params v1alpha1.StaticRoleParameters) map[string]interface{} {
+	data := map[string]interface{}{
+		"db_name":  params.DBName,
+		"username": params.Username,
+	}
+
+	if params.RotationPeriod != nil {
+		data["rotation_period"] = *params.RotationPeriod
+	}
+	if params.RotationSchedule != nil {
+		data["rotation_schedule"] = *params.RotationSchedule
+	}
+	if params.RotationWindow != nil {
+		data["rotation_window"] = *params.RotationWindow
+	}
+	if params.RotationStatements != nil {
+		data["rotation_statements"] = params.RotationStatements
+	}
+	if params.CredentialType != nil {
+		data["credential_type"] = *params.CredentialType
+	}
+	if params.CredentialConfig != nil {
+		data["credential_config"] = params.CredentialConfig
+	}
+
+	return data
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretmetadata
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotSecretMetadata = "managed resource is not a SecretMetadata custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errRead   = "cannot read KV secret metadata"
+	errWrite  = "cannot write KV secret metadata"
+	errPurge  = "cannot purge KV secret"
+	errDecode = "error decoding KV secret metadata returned by vault"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles SecretMetadata managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecretMetadataGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecretMetadataGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SecretMetadata{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecretMetadata)
+	if !ok {
+		return nil, errors.New(errNotSecretMetadata)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe reads the metadata of the secret. Only the parameters set in the
+// managed resource are compared, so that the metadata written along with the
+// values of the secret, by others, is not reported as drift. A SecretMetadata
+// being deleted that does not purge the secret is reported as not existing, as
+// Delete leaves the secret in place.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecretMetadata)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecretMetadata)
+	}
+
+	if meta.WasDeleted(cr) && !pointer.BoolDeref(cr.Spec.ForProvider.PurgeOnDelete, false) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	secret, err := c.client.Logical().Read(metadataPath(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	vaultData, err := fromVault(secret.Data)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	cr.Status.AtProvider = vaultData.Observation()
+
+	upToDate := cmp.Equal(*fromCrossplane(cr.Spec.ForProvider), *vaultData,
+		cmpopts.EquateEmpty(),
+		clients.IgnoreUnset(cr.Spec.ForProvider, VaultSecretMetadata{}),
+		// vault sets these as the secret is written
+		cmpopts.IgnoreFields(VaultSecretMetadata{}, "CurrentVersion", "OldestVersion", "CreatedTime", "UpdatedTime"))
+
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the metadata of the secret, which vault accepts before any
+// version of the secret is written
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SecretMetadata)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecretMetadata)
+	}
+
+	if err := c.writeMetadata(cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the metadata of the secret. Lowering maxVersions only drops
+// the older versions once the next version is written.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SecretMetadata)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecretMetadata)
+	}
+
+	if err := c.writeMetadata(cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete leaves the secret in place, unless the SecretMetadata is set to
+// purge it, which deletes all of its versions and its metadata for good
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SecretMetadata)
+	if !ok {
+		return errors.New(errNotSecretMetadata)
+	}
+
+	if !pointer.BoolDeref(cr.Spec.ForProvider.PurgeOnDelete, false) {
+		c.logger.Debug("Leaving KV secret metadata in place", "path", metadataPath(cr))
+		return nil
+	}
+
+	c.logger.Debug("Purging KV secret", "path", metadataPath(cr))
+	if _, err := c.client.Logical().Delete(metadataPath(cr)); err != nil {
+		return errors.Wrap(err, errPurge)
+	}
+
+	return nil
+}
+
+func (c *external) writeMetadata(cr *v1alpha1.SecretMetadata) error {
+	c.logger.Debug("Writing KV secret metadata", "path", metadataPath(cr))
+	if _, err := c.client.Logical().Write(metadataPath(cr), encode(cr.Spec.ForProvider)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+	return nil
+}
+
+func metadataPath(cr *v1alpha1.SecretMetadata) string {
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/metadata/" + strings.Trim(meta.GetExternalName(cr), "/")
+}
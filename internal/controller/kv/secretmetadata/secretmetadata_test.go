@@ -0,0 +1,480 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretmetadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testMetadataPath = "secret/metadata/team/app"
+	testCreatedTime  = "2022-10-04T12:00:00.000000001Z"
+	testUpdatedTime  = "2022-10-05T08:30:00.000000001Z"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalObservation
+		atProvider v1alpha1.SecretMetadataObservation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "vault returns no metadata for a secret that was never written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"error reading": {
+			reason: "metadata could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"deleted": {
+			reason: "a SecretMetadata being deleted that does not purge the secret must be reported as not existing without reading it, so that its finalizer is removed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletion(getTestMetadata()),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"deleted with purge": {
+			reason: "a SecretMetadata being deleted that purges the secret must be observed, so that the secret is purged",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletion(withPurgeOnDelete(getTestMetadata())),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: getTestObservation(),
+			},
+		},
+		"up to date": {
+			reason: "parameters left unset and the versions vault counts must not be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: getTestObservation(),
+			},
+		},
+		"retention drifted": {
+			reason: "a delete_version_after that differs from the managed resource must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["delete_version_after"] = "0s"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: data}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: getTestObservation(),
+			},
+		},
+		"custom metadata drifted": {
+			reason: "custom metadata written by others must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["custom_metadata"] = map[string]interface{}{"owner": "platform", "rotated": "2022-10-01"}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: data}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: getTestObservation(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr, _ := tc.args.mg.(*v1alpha1.SecretMetadata)
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully create": {
+			reason: "only the parameters set in the managed resource must be written",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"max_versions":         5,
+						"delete_version_after": 2592000,
+						"custom_metadata":      map[string]string{"owner": "platform"},
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testMetadataPath, data).Return(nil, nil)
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"fail writing": {
+			reason: "vault rejects the metadata",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testMetadataPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"leave in place": {
+			reason: "the secret must be left in place by default",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestMetadata(),
+			},
+		},
+		"purge": {
+			reason: "the secret must be purged when the SecretMetadata is set to",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testMetadataPath).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withPurgeOnDelete(getTestMetadata()),
+			},
+		},
+		"fail purging": {
+			reason: "unexpected error purging a KV secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testMetadataPath).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withPurgeOnDelete(getTestMetadata()),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errPurge),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestMetadata() *v1alpha1.SecretMetadata {
+	metadata := &v1alpha1.SecretMetadata{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.SecretMetadataKind,
+			APIVersion: v1alpha1.SecretMetadataKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app",
+		},
+		Spec: v1alpha1.SecretMetadataSpec{
+			ForProvider: v1alpha1.SecretMetadataParameters{
+				Mount:              "secret",
+				MaxVersions:        pointer.Int(5),
+				DeleteVersionAfter: pointer.Int(2592000),
+				CustomMetadata:     map[string]string{"owner": "platform"},
+			},
+		},
+	}
+	meta.SetExternalName(metadata, "team/app")
+	return metadata
+}
+
+func withPurgeOnDelete(metadata *v1alpha1.SecretMetadata) *v1alpha1.SecretMetadata {
+	metadata.Spec.ForProvider.PurgeOnDelete = pointer.Bool(true)
+	return metadata
+}
+
+func withDeletion(metadata *v1alpha1.SecretMetadata) *v1alpha1.SecretMetadata {
+	metadata.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	return metadata
+}
+
+func getTestObservation() v1alpha1.SecretMetadataObservation {
+	created, _ := time.Parse(time.RFC3339Nano, testCreatedTime)
+	updated, _ := time.Parse(time.RFC3339Nano, testUpdatedTime)
+	createdTime, updatedTime := metav1.NewTime(created), metav1.NewTime(updated)
+	return v1alpha1.SecretMetadataObservation{
+		CurrentVersion: 3,
+		OldestVersion:  1,
+		CreatedTime:    &createdTime,
+		UpdatedTime:    &updatedTime,
+	}
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"cas_required":         false,
+		"created_time":         testCreatedTime,
+		"current_version":      3,
+		"custom_metadata":      map[string]interface{}{"owner": "platform"},
+		"delete_version_after": "720h0m0s",
+		"max_versions":         5,
+		"oldest_version":       1,
+		"updated_time":         testUpdatedTime,
+		"versions":             map[string]interface{}{},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
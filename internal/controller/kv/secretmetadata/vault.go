@@ -0,0 +1,83 @@
+package secretmetadata
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultSecretMetadata is an helper struct to compare the metadata of the
+// crossplane resource with the one vault holds. Vault returns
+// delete_version_after as a duration, which is decoded as seconds.
+type VaultSecretMetadata struct {
+	MaxVersions        int               `json:"max_versions"`
+	CASRequired        bool              `json:"cas_required"`
+	DeleteVersionAfter int               `json:"delete_version_after"`
+	CustomMetadata     map[string]string `json:"custom_metadata"`
+
+	CurrentVersion int       `json:"current_version"`
+	OldestVersion  int       `json:"oldest_version"`
+	CreatedTime    time.Time `json:"created_time"`
+	UpdatedTime    time.Time `json:"updated_time"`
+}
+
+// Observation returns the status of a SecretMetadata holding m
+func (m *VaultSecretMetadata) Observation() v1alpha1.SecretMetadataObservation {
+	return v1alpha1.SecretMetadataObservation{
+		CurrentVersion: m.CurrentVersion,
+		OldestVersion:  m.OldestVersion,
+		CreatedTime:    toMetaTime(m.CreatedTime),
+		UpdatedTime:    toMetaTime(m.UpdatedTime),
+	}
+}
+
+func fromCrossplane(params v1alpha1.SecretMetadataParameters) *VaultSecretMetadata {
+	return &VaultSecretMetadata{
+		MaxVersions:        pointer.IntDeref(params.MaxVersions, 0),
+		CASRequired:        pointer.BoolDeref(params.CASRequired, false),
+		DeleteVersionAfter: pointer.IntDeref(params.DeleteVersionAfter, 0),
+		CustomMetadata:     params.CustomMetadata,
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultSecretMetadata, error) {
+	metadata := &VaultSecretMetadata{}
+	if err := clients.DecodeData(data, metadata); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return metadata, nil
+}
+
+// encode prepares the data to be sent to vault. Only the parameters set in
+// the managed resource are sent, so that vault keeps the rest as they are.
+func encode(params v1alpha1.SecretMetadataParameters) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if params.MaxVersions != nil {
+		data["max_versions"] = *params.MaxVersions
+	}
+	if params.CASRequired != nil {
+		data["cas_required"] = *params.CASRequired
+	}
+	if params.DeleteVersionAfter != nil {
+		data["delete_version_after"] = *params.DeleteVersionAfter
+	}
+	if params.CustomMetadata != nil {
+		data["custom_metadata"] = params.CustomMetadata
+	}
+
+	return data
+}
+
+func toMetaTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
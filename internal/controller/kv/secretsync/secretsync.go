@@ -0,0 +1,297 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsync
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	vault "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotSecretSync     = "managed resource is not a SecretSync custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errRead         = "cannot read KV secret"
+	errDecode       = "error decoding KV secret returned by vault"
+	errNotFound     = "KV secret does not exist, a SecretSync only reads it"
+	errMissingField = "KV secret has no field %q"
+	errVersionV1    = "version can only be set with KV version 2"
+	errPublish      = "cannot create or update connection secret"
+
+	defaultEngineVersion = 2
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles SecretSync managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecretSyncGroupKind)
+
+	cps := []managed.ConnectionPublisher{newReplacingSecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecretSyncGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SecretSync{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A replacingSecretPublisher publishes the connection details to the Secret
+// the SecretSync writes its connection secret to, replacing its data as a
+// whole so that keys removed from the vault secret are removed from it too.
+// The API secret publisher of the managed reconciler patches the Secret
+// instead, which keeps them. Nil details leave the Secret as it is.
+type replacingSecretPublisher struct {
+	secret resource.Applicator
+	typer  runtime.ObjectTyper
+}
+
+func newReplacingSecretPublisher(c client.Client, ot runtime.ObjectTyper) *replacingSecretPublisher {
+	return &replacingSecretPublisher{
+		secret: resource.NewApplicatorWithRetry(resource.NewAPIUpdatingApplicator(c), resource.IsAPIErrorWrapped, nil),
+		typer:  ot,
+	}
+}
+
+// PublishConnection replaces the data of the connection secret with the
+// details, unless they are the same already
+func (p *replacingSecretPublisher) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+	if o.GetWriteConnectionSecretToReference() == nil || c == nil {
+		return false, nil
+	}
+
+	s := resource.ConnectionSecretFor(o, resource.MustGetKind(o, p.typer))
+	s.Data = c
+	err := p.secret.Apply(ctx, s,
+		resource.ConnectionSecretMustBeControllableBy(o.GetUID()),
+		resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
+			return !cmp.Equal(current.(*corev1.Secret).Data, desired.(*corev1.Secret).Data, cmpopts.EquateEmpty())
+		}),
+	)
+	if resource.IsNotAllowed(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errPublish)
+	}
+	return true, nil
+}
+
+// UnpublishConnection does nothing, as the connection secret is garbage
+// collected along with the SecretSync controlling it
+func (p *replacingSecretPublisher) UnpublishConnection(_ context.Context, _ resource.ConnectionSecretOwner, _ managed.ConnectionDetails) error {
+	return nil
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecretSync)
+	if !ok {
+		return nil, errors.New(errNotSecretSync)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	logger logging.Logger
+}
+
+// Observe reads the secret and returns its data as connection details, which
+// the managed reconciler publishes on every poll. A secret that does not
+// exist is reported as such, not as an error, with no connection details so
+// that the connection secret is left as it is. A SecretSync being deleted is
+// reported as not existing without reading the secret, as Delete leaves the
+// secret in place and the finalizer would otherwise never be removed.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecretSync)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecretSync)
+	}
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	params := cr.Spec.ForProvider
+	if params.Version != nil && engineVersion(params) != 2 {
+		return managed.ExternalObservation{}, errors.New(errVersionV1)
+	}
+
+	secret, err := c.readSecret(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+	if secret == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	data := secret.Data
+	version := 0
+	if engineVersion(params) == 2 {
+		// vault returns null data for deleted and destroyed versions, and an
+		// empty object for a version written with no keys
+		if secret.Data["data"] == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		vaultData, err := fromVault(secret.Data)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+		}
+		data = vaultData.Data
+		version = vaultData.Metadata.Version
+	}
+
+	details, err := connectionDetails(data, params.Fields)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	keys := make([]string, 0, len(details))
+	for k := range details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	cr.Status.AtProvider = v1alpha1.SecretSyncObservation{
+		Version: version,
+		Keys:    keys,
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: details,
+	}, nil
+}
+
+// Create fails, as a SecretSync never writes the secret it reads
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errNotFound)
+}
+
+// Update does nothing, as a SecretSync is always up to date with the secret
+// it reads
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete leaves the secret in place
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SecretSync)
+	if !ok {
+		return errors.New(errNotSecretSync)
+	}
+
+	c.logger.Debug("Leaving KV secret in place", "path", secretPath(cr))
+	return nil
+}
+
+// readSecret reads the secret, at the version set for KV version 2 secrets
+func (c *external) readSecret(cr *v1alpha1.SecretSync) (*vault.Secret, error) {
+	if v := cr.Spec.ForProvider.Version; v != nil {
+		return c.client.Logical().ReadWithData(secretPath(cr), map[string][]string{
+			"version": {strconv.Itoa(*v)},
+		})
+	}
+	return c.client.Logical().Read(secretPath(cr))
+}
+
+func engineVersion(params v1alpha1.SecretSyncParameters) int {
+	return pointer.IntDeref(params.EngineVersion, defaultEngineVersion)
+}
+
+func secretPath(cr *v1alpha1.SecretSync) string {
+	path := strings.Trim(meta.GetExternalName(cr), "/")
+	if engineVersion(cr.Spec.ForProvider) == 2 {
+		path = "data/" + path
+	}
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/" + path
+}
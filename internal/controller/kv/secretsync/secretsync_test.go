@@ -0,0 +1,526 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsync
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testV2Path = "secret/data/team/app"
+	testV1Path = "kv/team/app"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalObservation
+		atProvider v1alpha1.SecretSyncObservation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "a secret that does not exist must be reported as such, not as an error, leaving the connection secret as it is",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"deleted": {
+			reason: "a SecretSync being deleted must be reported as not existing without reading the secret, so that its finalizer is removed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletion(getTestSync()),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"error reading": {
+			reason: "secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"current version": {
+			reason: "all keys of the current version must be published, values other than strings as JSON",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(&api.Secret{Data: getVaultV2Data(4)}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"username": []byte("app"),
+						"password": []byte("s3cr3t"),
+						"port":     []byte("5432"),
+					},
+				},
+				atProvider: v1alpha1.SecretSyncObservation{
+					Version: 4,
+					Keys:    []string{"password", "port", "username"},
+				},
+			},
+		},
+		"pinned version and fields": {
+			reason: "only the fields set must be published, from the version set",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().ReadWithData(testV2Path, map[string][]string{"version": {"2"}}).Return(&api.Secret{Data: getVaultV2Data(2)}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withFields(withVersion(getTestSync(), 2), "password"),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"password": []byte("s3cr3t"),
+					},
+				},
+				atProvider: v1alpha1.SecretSyncObservation{
+					Version: 2,
+					Keys:    []string{"password"},
+				},
+			},
+		},
+		"deleted version": {
+			reason: "vault returns no data for a deleted version, which must be reported as not existing",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultV2Data(4)
+					data["data"] = nil
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(&api.Secret{Data: data}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"empty secret": {
+			reason: "a version written with no keys exists, and must publish no keys",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultV2Data(4)
+					data["data"] = map[string]interface{}{}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(&api.Secret{Data: data}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretSyncObservation{
+					Version: 4,
+					Keys:    []string{},
+				},
+			},
+		},
+		"missing field": {
+			reason: "a field the secret does not have must be reported as an error",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV2Path).Return(&api.Secret{Data: getVaultV2Data(4)}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withFields(getTestSync(), "token"),
+			},
+			want: want{
+				err: errors.Errorf(errMissingField, "token"),
+			},
+		},
+		"kv version 1": {
+			reason: "the data of a KV version 1 secret must be read from <mount>/<path>",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testV1Path).Return(&api.Secret{Data: map[string]interface{}{
+						"username": "app",
+					}}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestV1Sync(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"username": []byte("app"),
+					},
+				},
+				atProvider: v1alpha1.SecretSyncObservation{
+					Keys: []string{"username"},
+				},
+			},
+		},
+		"version with kv version 1": {
+			reason: "KV version 1 secrets have no versions",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, _ := newMock(t)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVersion(getTestV1Sync(), 2),
+			},
+			want: want{
+				err: errors.New(errVersionV1),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr, _ := tc.args.mg.(*v1alpha1.SecretSync)
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"never writes": {
+			reason: "a SecretSync must never write the secret it reads",
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSync(),
+			},
+			want: want{
+				err: errors.New(errNotFound),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{logger: logging.NewNopLogger()}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPublishConnection(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		o   resource.ConnectionSecretOwner
+		c   managed.ConnectionDetails
+	}
+
+	type want struct {
+		published bool
+		data      map[string][]byte
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"key removed": {
+			reason: "a key no longer in the vault secret must be removed from the connection secret",
+			args: args{
+				ctx: context.TODO(),
+				o:   getTestSync(),
+				c:   managed.ConnectionDetails{"username": []byte("app")},
+			},
+			want: want{
+				published: true,
+				data:      map[string][]byte{"username": []byte("app")},
+			},
+		},
+		"unchanged": {
+			reason: "the connection secret must not be updated when it holds the details already",
+			args: args{
+				ctx: context.TODO(),
+				o:   getTestSync(),
+				c:   managed.ConnectionDetails{"username": []byte("app"), "password": []byte("s3cr3t")},
+			},
+			want: want{
+				published: false,
+			},
+		},
+		"no details": {
+			reason: "nil details, observed while the vault secret does not exist, must leave the connection secret as it is",
+			args: args{
+				ctx: context.TODO(),
+				o:   getTestSync(),
+			},
+			want: want{
+				published: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var data map[string][]byte
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Name != "app" || key.Namespace != "default" {
+						return errors.New("unexpected secret")
+					}
+					s := resource.ConnectionSecretFor(tc.args.o, v1alpha1.SecretSyncGroupVersionKind)
+					s.Data = map[string][]byte{"username": []byte("app"), "password": []byte("s3cr3t")}
+					s.DeepCopyInto(obj.(*corev1.Secret))
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					data = obj.(*corev1.Secret).Data
+					return nil
+				},
+			}
+			p := newReplacingSecretPublisher(kube, scheme.Scheme)
+			_ = v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
+
+			published, err := p.PublishConnection(tc.args.ctx, tc.args.o, tc.args.c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\np.PublishConnection(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("\n%s\np.PublishConnection(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("\n%s\np.PublishConnection(...): -want data, +got data:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func getTestSync() *v1alpha1.SecretSync {
+	sync := &v1alpha1.SecretSync{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.SecretSyncKind,
+			APIVersion: v1alpha1.SecretSyncKindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app",
+		},
+		Spec: v1alpha1.SecretSyncSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: "app", Namespace: "default"},
+			},
+			ForProvider: v1alpha1.SecretSyncParameters{
+				Mount:         "secret",
+				EngineVersion: pointer.Int(2),
+			},
+		},
+	}
+	meta.SetExternalName(sync, "team/app")
+	return sync
+}
+
+func getTestV1Sync() *v1alpha1.SecretSync {
+	sync := getTestSync()
+	sync.Spec.ForProvider.Mount = "kv"
+	sync.Spec.ForProvider.EngineVersion = pointer.Int(1)
+	return sync
+}
+
+func withVersion(sync *v1alpha1.SecretSync, version int) *v1alpha1.SecretSync {
+	sync.Spec.ForProvider.Version = pointer.Int(version)
+	return sync
+}
+
+func withFields(sync *v1alpha1.SecretSync, fields ...string) *v1alpha1.SecretSync {
+	sync.Spec.ForProvider.Fields = fields
+	return sync
+}
+
+func withDeletion(sync *v1alpha1.SecretSync) *v1alpha1.SecretSync {
+	sync.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+	return sync
+}
+
+func getVaultV2Data(version int) map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"username": "app",
+			"password": "s3cr3t",
+			"port":     json.Number("5432"),
+		},
+		"metadata": map[string]interface{}{
+			"created_time":  "2022-10-04T12:00:00.000000001Z",
+			"deletion_time": "",
+			"destroyed":     false,
+			"version":       version,
+		},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
@@ -0,0 +1,58 @@
+package secretsync
+
+import (
+	"encoding/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultSecretV2 is a version of a KV version 2 secret as returned by
+// <mount>/data/<path>
+type VaultSecretV2 struct {
+	Data     map[string]interface{} `json:"data"`
+	Metadata struct {
+		Version int `json:"version"`
+	} `json:"metadata"`
+}
+
+func fromVault(data map[string]interface{}) (*VaultSecretV2, error) {
+	secret := &VaultSecretV2{}
+	if err := clients.DecodeData(data, secret); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return secret, nil
+}
+
+// connectionDetails returns the fields of the secret, or all of its keys when
+// no field is set. String values are published as they are, and any other
+// value as JSON.
+func connectionDetails(data map[string]interface{}, fields []string) (managed.ConnectionDetails, error) {
+	if fields == nil {
+		fields = make([]string, 0, len(data))
+		for k := range data {
+			fields = append(fields, k)
+		}
+	}
+
+	details := make(managed.ConnectionDetails, len(fields))
+	for _, f := range fields {
+		value, ok := data[f]
+		if !ok {
+			return nil, errors.Errorf(errMissingField, f)
+		}
+
+		if s, ok := value.(string); ok {
+			details[f] = []byte(s)
+			continue
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrap(err, errDecode)
+		}
+		details[f] = b
+	}
+	return details, nil
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretv1
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotSecretV1       = "managed resource is not a SecretV1 custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errRead      = "cannot read KV secret"
+	errWrite     = "cannot write KV secret"
+	errDelete    = "cannot delete KV secret"
+	errSecretRef = "cannot get KV secret value"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles SecretV1 managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecretV1GroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecretV1GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SecretV1{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecretV1)
+	if !ok {
+		return nil, errors.New(errNotSecretV1)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the values of the secret from the referenced Secrets
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the secret and compares its data with the data of the managed
+// resource. Nothing is recorded in the status, so that the values read from the
+// referenced Secrets never show there.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecretV1)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecretV1)
+	}
+
+	secret, err := c.client.Logical().Read(secretPath(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	if secret == nil {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// deleting a KV v1 secret removes all of its keys at once, so they are
+	// not resolved from Secrets that may be deleted in the same apply
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	data, err := c.resolveData(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := isUpToDate(data, secret.Data)
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the secret
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SecretV1)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecretV1)
+	}
+
+	if err := c.writeSecret(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes the secret again, replacing all of its keys, since KV v1
+// keeps no versions.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SecretV1)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecretV1)
+	}
+
+	if err := c.writeSecret(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete deletes the secret, which KV version 1 cannot undo
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SecretV1)
+	if !ok {
+		return errors.New(errNotSecretV1)
+	}
+
+	c.logger.Debug("Deleting KV secret", "path", secretPath(cr))
+	if _, err := c.client.Logical().Delete(secretPath(cr)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+// writeSecret replaces the data of the secret as a whole
+func (c *external) writeSecret(ctx context.Context, cr *v1alpha1.SecretV1) error {
+	data, err := c.resolveData(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("Writing KV secret", "path", secretPath(cr))
+	if _, err := c.client.Logical().Write(secretPath(cr), encode(data)); err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+	return nil
+}
+
+// resolveData merges the inline data of the secret with the values read from
+// the referenced Secrets
+func (c *external) resolveData(ctx context.Context, params v1alpha1.SecretV1Parameters) (map[string]string, error) {
+	data := make(map[string]string, len(params.Data)+len(params.DataSecretRefs))
+	for k, v := range params.Data {
+		data[k] = v
+	}
+
+	for _, ref := range params.DataSecretRefs {
+		ref := ref
+		value, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: &ref.ValueSecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errSecretRef)
+		}
+		data[ref.Key] = string(value)
+	}
+
+	return data, nil
+}
+
+func secretPath(cr *v1alpha1.SecretV1) string {
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/" + strings.Trim(meta.GetExternalName(cr), "/")
+}
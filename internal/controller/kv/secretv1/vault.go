@@ -0,0 +1,36 @@
+package secretv1
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultSecretV1 is a secret as returned by <mount>/<path>, which holds the
+// data of the secret at the top level
+type VaultSecretV1 struct {
+	Data map[string]string `json:"data"`
+}
+
+// isUpToDate compares the secret as a whole: keys vault holds that are not
+// part of the managed resource are drift too. Numbers and booleans written by
+// others compare as their string form, while lists and maps, which cannot be
+// decoded as strings, are always drift.
+func isUpToDate(data map[string]string, vaultData map[string]interface{}) bool {
+	secret := &VaultSecretV1{}
+	if err := clients.DecodeData(map[string]interface{}{"data": vaultData}, secret); err != nil {
+		return false
+	}
+	return cmp.Equal(data, secret.Data, cmpopts.EquateEmpty())
+}
+
+// encode prepares the data to be sent to vault, which KV version 1 stores
+// as it is
+func encode(data map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		out[k] = v
+	}
+	return out
+}
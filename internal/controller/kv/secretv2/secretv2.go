@@ -0,0 +1,369 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretv2
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/controller/features"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errNotSecretV2       = "managed resource is not a SecretV2 custom resource"
+	errNewExternalClient = "cannot create vault client from config"
+
+	errRead         = "cannot read KV secret"
+	errReadMetadata = "cannot read KV secret metadata"
+	errWrite        = "cannot write KV secret"
+	errDelete       = "cannot delete KV secret"
+	errDestroy      = "cannot destroy KV secret versions"
+	errDecode       = "error decoding KV secret returned by vault"
+	errSecretRef    = "cannot get KV secret value"
+
+	deletionModeDestroy = "Destroy"
+)
+
+// A NoOpService does nothing.
+type NoOpService struct{}
+
+var (
+	newNoOpService = func(_ []byte) (interface{}, error) { return &NoOpService{}, nil }
+)
+
+// Setup adds a controller that reconciles SecretV2 managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecretV2GroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecretV2GroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:         mgr.GetClient(),
+			usage:        resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn: newNoOpService,
+			logger:       o.Logger}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SecretV2{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube         client.Client
+	usage        resource.Tracker
+	newServiceFn func(creds []byte) (interface{}, error)
+	logger       logging.Logger
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecretV2)
+	if !ok {
+		return nil, errors.New(errNotSecretV2)
+	}
+
+	vaultClient, err := clients.NewVaultClient(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewExternalClient)
+	}
+
+	return &external{
+		client: vaultClient,
+		kube:   c.kube,
+		logger: c.logger,
+	}, nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	client clients.VaultClient
+
+	// kube reads the values of the secret from the referenced Secrets
+	kube client.Client
+
+	logger logging.Logger
+}
+
+// Observe reads the current version of the secret and records it in the
+// status, so that the next write is checked and set against it. A secret
+// whose current version was deleted or destroyed is reported as not existing,
+// and is written again on top of that version.
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecretV2)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecretV2)
+	}
+
+	secret, err := c.client.Logical().Read(dataPath(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+	}
+
+	var vaultData *VaultSecretV2
+	if secret != nil {
+		if vaultData, err = fromVault(secret.Data); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+		}
+	}
+
+	// vault returns no data once the current version is deleted, but keeps
+	// counting versions from it
+	if vaultData == nil || vaultData.Metadata.Deleted() {
+		metadata, err := c.readMetadata(cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errReadMetadata)
+		}
+		cr.Status.AtProvider = v1alpha1.SecretV2Observation{}
+		if metadata != nil {
+			cr.Status.AtProvider.Version = metadata.CurrentVersion
+		}
+
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	cr.Status.AtProvider = vaultData.Metadata.Observation()
+
+	// Delete only needs the path, so the referenced Secrets, which may be
+	// deleted in the same apply, are not read
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	data, err := c.resolveData(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := isUpToDate(data, vaultData.Data)
+	if upToDate {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create writes the first version of the secret, or a version on top of the
+// deleted one observed
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SecretV2)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecretV2)
+	}
+
+	if err := c.writeSecret(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Update writes a new version of the secret on top of the version observed
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SecretV2)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecretV2)
+	}
+
+	if err := c.writeSecret(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Delete soft deletes the current version of the secret, or destroys all of
+// its versions, as set by the deletion mode
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SecretV2)
+	if !ok {
+		return errors.New(errNotSecretV2)
+	}
+
+	if pointer.StringDeref(cr.Spec.ForProvider.DeletionMode, "") == deletionModeDestroy {
+		return c.destroyVersions(cr)
+	}
+
+	c.logger.Debug("Deleting KV secret", "path", dataPath(cr))
+	if _, err := c.client.Logical().Delete(dataPath(cr)); err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	return nil
+}
+
+// writeSecret writes the secret checked and set against the version observed,
+// so that vault rejects the write when someone else wrote a version since.
+// The version written is recorded in the status.
+func (c *external) writeSecret(ctx context.Context, cr *v1alpha1.SecretV2) error {
+	data, err := c.resolveData(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+
+	cas := cr.Status.AtProvider.Version
+	c.logger.Debug("Writing KV secret", "path", dataPath(cr), "cas", cas)
+	secret, err := c.client.Logical().Write(dataPath(cr), encode(data, cas))
+	if err != nil {
+		return errors.Wrap(err, errWrite)
+	}
+
+	if secret != nil {
+		metadata, err := versionFromVault(secret.Data)
+		if err != nil {
+			return errors.Wrap(err, errWrite)
+		}
+		cr.Status.AtProvider = metadata.Observation()
+	}
+	return nil
+}
+
+// destroyVersions permanently removes the data of the versions of the secret
+// not destroyed yet
+func (c *external) destroyVersions(cr *v1alpha1.SecretV2) error {
+	metadata, err := c.readMetadata(cr)
+	if err != nil {
+		return errors.Wrap(err, errReadMetadata)
+	}
+	if metadata == nil {
+		return nil
+	}
+
+	versions := []int{}
+	for v, version := range metadata.Versions {
+		if version.Destroyed {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrap(err, errDecode)
+		}
+		versions = append(versions, n)
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+	sort.Ints(versions)
+
+	c.logger.Debug("Destroying KV secret versions", "path", destroyPath(cr), "versions", versions)
+	if _, err := c.client.Logical().Write(destroyPath(cr), map[string]interface{}{"versions": versions}); err != nil {
+		return errors.Wrap(err, errDestroy)
+	}
+	return nil
+}
+
+// readMetadata returns nil when the secret never existed or its metadata was
+// deleted
+func (c *external) readMetadata(cr *v1alpha1.SecretV2) (*VaultSecretMetadata, error) {
+	secret, err := c.client.Logical().Read(metadataPath(cr))
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	return metadataFromVault(secret.Data)
+}
+
+// resolveData merges the inline data of the secret with the values read from
+// the referenced Secrets
+func (c *external) resolveData(ctx context.Context, params v1alpha1.SecretV2Parameters) (map[string]string, error) {
+	data := make(map[string]string, len(params.Data)+len(params.DataSecretRefs))
+	for k, v := range params.Data {
+		data[k] = v
+	}
+
+	for _, ref := range params.DataSecretRefs {
+		ref := ref
+		value, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: &ref.ValueSecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, errSecretRef)
+		}
+		data[ref.Key] = string(value)
+	}
+
+	return data, nil
+}
+
+func dataPath(cr *v1alpha1.SecretV2) string {
+	return secretPath(cr, "data")
+}
+
+func metadataPath(cr *v1alpha1.SecretV2) string {
+	return secretPath(cr, "metadata")
+}
+
+func destroyPath(cr *v1alpha1.SecretV2) string {
+	return secretPath(cr, "destroy")
+}
+
+func secretPath(cr *v1alpha1.SecretV2, endpoint string) string {
+	return strings.Trim(cr.Spec.ForProvider.Mount, "/") + "/" + endpoint + "/" + strings.Trim(meta.GetExternalName(cr), "/")
+}
@@ -0,0 +1,695 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretv2
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+const (
+	testDataPath     = "secret/data/team/app"
+	testMetadataPath = "secret/metadata/team/app"
+	testDestroyPath  = "secret/destroy/team/app"
+	testCreatedTime  = "2022-10-04T12:00:00.000000001Z"
+)
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalObservation
+		atProvider v1alpha1.SecretV2Observation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"does not exist": {
+			reason: "a secret that was never written must be created checked and set against version 0",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(nil, nil)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"current version deleted": {
+			reason: "a secret whose current version was deleted must be written again on top of that version",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(nil, nil)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: map[string]interface{}{
+						"current_version": json.Number("3"),
+						"versions":        map[string]interface{}{},
+					}}, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{Version: 3},
+			},
+		},
+		"error reading": {
+			reason: "secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errRead),
+			},
+		},
+		"up to date": {
+			reason: "the inline data and the values of the referenced Secrets match the current version",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+				kube: getTestKube("s3cr3t"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     2,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+		"referenced value changed": {
+			reason: "a value read from a referenced Secret that differs from vault must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+				kube: getTestKube("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     2,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+		"key added in vault": {
+			reason: "a key written by someone else must be reported as drift",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := getVaultData()
+					data["data"].(map[string]interface{})["debug"] = "true"
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(&api.Secret{Data: data}, nil)
+					return clientMock
+				},
+				kube: getTestKube("s3cr3t"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     2,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+		"fail reading referenced value": {
+			reason: "the referenced Secret could not be read",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errSecretRef),
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     2,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+		"deleted": {
+			reason: "a secret being deleted must not read the referenced Secrets, which may be gone already",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testDataPath).Return(&api.Secret{Data: getVaultData()}, nil)
+					return clientMock
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletion(getTestSecret()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     2,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr, _ := tc.args.mg.(*v1alpha1.SecretV2)
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalCreation
+		atProvider v1alpha1.SecretV2Observation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"on top of a deleted version": {
+			reason: "a secret whose current version was deleted must be written checked and set against that version",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"data": map[string]string{
+							"username": "app",
+							"password": "s3cr3t",
+						},
+						"options": map[string]interface{}{
+							"cas": 3,
+						},
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testDataPath, data).Return(&api.Secret{Data: map[string]interface{}{
+						"version":       json.Number("4"),
+						"created_time":  testCreatedTime,
+						"deletion_time": "",
+						"destroyed":     false,
+					}}, nil)
+
+					return clientMock
+				},
+				kube: getTestKube("s3cr3t"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVersion(getTestSecret(), 3),
+			},
+			want: want{
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     4,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr, _ := tc.args.mg.(*v1alpha1.SecretV2)
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+		kube          client.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalUpdate
+		atProvider v1alpha1.SecretV2Observation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"successfully update": {
+			reason: "a new version must be written checked and set against the version observed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					data := map[string]interface{}{
+						"data": map[string]string{
+							"username": "app",
+							"password": "rotated",
+						},
+						"options": map[string]interface{}{
+							"cas": 2,
+						},
+					}
+
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testDataPath, data).Return(&api.Secret{Data: map[string]interface{}{
+						"version":       json.Number("3"),
+						"created_time":  testCreatedTime,
+						"deletion_time": "",
+						"destroyed":     false,
+					}}, nil)
+
+					return clientMock
+				},
+				kube: getTestKube("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVersion(getTestSecret(), 2),
+			},
+			want: want{
+				o: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				atProvider: v1alpha1.SecretV2Observation{
+					Version:     3,
+					CreatedTime: getTestCreatedTime(),
+				},
+			},
+		},
+		"concurrent write": {
+			reason: "vault rejects the write when a version was written since the one observed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Write(testDataPath, gomock.Any()).Return(nil, vaultMockError())
+
+					return clientMock
+				},
+				kube: getTestKube("rotated"),
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withVersion(getTestSecret(), 2),
+			},
+			want: want{
+				atProvider: v1alpha1.SecretV2Observation{Version: 2},
+				err:        errors.Wrap(vaultMockError(), errWrite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				kube:   tc.fields.kube,
+				logger: logging.NewNopLogger(),
+			}
+			got, err := e.Update(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr, _ := tc.args.mg.(*v1alpha1.SecretV2)
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		clientBuilder func(t *testing.T) clients.VaultClient
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"soft delete": {
+			reason: "the current version must be soft deleted by default",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testDataPath).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+		},
+		"destroy": {
+			reason: "the versions not destroyed yet must be destroyed",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(&api.Secret{Data: map[string]interface{}{
+						"current_version": json.Number("3"),
+						"versions": map[string]interface{}{
+							"1":  map[string]interface{}{"created_time": testCreatedTime, "deletion_time": "", "destroyed": true},
+							"2":  map[string]interface{}{"created_time": testCreatedTime, "deletion_time": testCreatedTime, "destroyed": false},
+							"3":  map[string]interface{}{"created_time": testCreatedTime, "deletion_time": "", "destroyed": false},
+							"10": map[string]interface{}{"created_time": testCreatedTime, "deletion_time": "", "destroyed": false},
+						},
+					}}, nil)
+					logicalMock.EXPECT().Write(testDestroyPath, map[string]interface{}{"versions": []int{2, 3, 10}}).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletionMode(getTestSecret(), deletionModeDestroy),
+			},
+		},
+		"destroy a secret already gone": {
+			reason: "there is nothing to destroy when vault has no metadata for the secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Read(testMetadataPath).Return(nil, nil)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  withDeletionMode(getTestSecret(), deletionModeDestroy),
+			},
+		},
+		"fail deleting": {
+			reason: "unexpected error deleting a KV secret",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+					clientMock, logicalMock := newMock(t)
+					logicalMock.EXPECT().Delete(testDataPath).Return(nil, vaultMockError())
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestSecret(),
+			},
+			want: want{
+				err: errors.Wrap(vaultMockError(), errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				client: tc.fields.clientBuilder(t),
+				logger: logging.NewNopLogger(),
+			}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+var errBoom = errors.New("boom")
+
+func getTestSecret() *v1alpha1.SecretV2 {
+	secret := &v1alpha1.SecretV2{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.SecretV2Kind,
+			APIVersion: v1alpha1.SecretV2KindAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app",
+		},
+		Spec: v1alpha1.SecretV2Spec{
+			ForProvider: v1alpha1.SecretV2Parameters{
+				Mount: "secret",
+				Data: map[string]string{
+					"username": "app",
+				},
+				DataSecretRefs: []v1alpha1.SecretDataRef{
+					{
+						Key: "password",
+						ValueSecretRef: xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Name: "app-db", Namespace: "default"},
+							Key:             "password",
+						},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(secret, "team/app")
+	return secret
+}
+
+func withVersion(secret *v1alpha1.SecretV2, version int) *v1alpha1.SecretV2 {
+	secret.Status.AtProvider.Version = version
+	return secret
+}
+
+func withDeletion(secret *v1alpha1.SecretV2) *v1alpha1.SecretV2 {
+	now := metav1.Now()
+	secret.SetDeletionTimestamp(&now)
+	return secret
+}
+
+func withDeletionMode(secret *v1alpha1.SecretV2, mode string) *v1alpha1.SecretV2 {
+	secret.Spec.ForProvider.DeletionMode = pointer.String(mode)
+	return secret
+}
+
+func getTestKube(password string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "app-db" || key.Namespace != "default" {
+				return errors.New("unexpected secret")
+			}
+			obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(password)}
+			return nil
+		},
+	}
+}
+
+func getTestCreatedTime() *metav1.Time {
+	t, _ := time.Parse(time.RFC3339Nano, testCreatedTime)
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+func getVaultData() map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"username": "app",
+			"password": "s3cr3t",
+		},
+		"metadata": map[string]interface{}{
+			"created_time":    testCreatedTime,
+			"custom_metadata": nil,
+			"deletion_time":   "",
+			"destroyed":       false,
+			"version":         json.Number("2"),
+		},
+	}
+}
+
+func newMock(t *testing.T) (*fake.MockVaultClient, *fake.MockVaultLogicalClient) {
+	ctrl := gomock.NewController(t)
+	logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+	clientMock := fake.NewMockVaultClient(ctrl)
+	clientMock.EXPECT().Logical().Return(logicalMock).AnyTimes()
+
+	return clientMock, logicalMock
+}
+
+func vaultMockError() error {
+	return errors.New("fake error message")
+}
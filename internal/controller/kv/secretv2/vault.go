@@ -0,0 +1,108 @@
+package secretv2
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/topfreegames/crossplane-provider-vault/apis/kv/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
+)
+
+// VaultSecretV2 is the current version of a secret as returned by
+// <mount>/data/<path>
+type VaultSecretV2 struct {
+	Data     map[string]interface{} `json:"data"`
+	Metadata VaultVersionMetadata   `json:"metadata"`
+}
+
+// VaultVersionMetadata is the metadata vault keeps for each version of a
+// secret
+type VaultVersionMetadata struct {
+	Version      int       `json:"version"`
+	CreatedTime  time.Time `json:"created_time"`
+	DeletionTime time.Time `json:"deletion_time"`
+	Destroyed    bool      `json:"destroyed"`
+}
+
+// VaultSecretMetadata is the metadata of a secret as returned by
+// <mount>/metadata/<path>. Vault keys its versions by their number.
+type VaultSecretMetadata struct {
+	CurrentVersion int                             `json:"current_version"`
+	Versions       map[string]VaultVersionMetadata `json:"versions"`
+}
+
+// Deleted tells whether the version was destroyed or its deletion time, set
+// when it is deleted or by delete_version_after, has passed
+func (m VaultVersionMetadata) Deleted() bool {
+	return m.Destroyed || (!m.DeletionTime.IsZero() && m.DeletionTime.Before(time.Now()))
+}
+
+// Observation returns the status of a SecretV2 whose current version is m
+func (m VaultVersionMetadata) Observation() v1alpha1.SecretV2Observation {
+	return v1alpha1.SecretV2Observation{
+		Version:      m.Version,
+		CreatedTime:  toMetaTime(m.CreatedTime),
+		DeletionTime: toMetaTime(m.DeletionTime),
+	}
+}
+
+func fromVault(data map[string]interface{}) (*VaultSecretV2, error) {
+	secret := &VaultSecretV2{}
+	if err := clients.DecodeData(data, secret); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return secret, nil
+}
+
+func versionFromVault(data map[string]interface{}) (*VaultVersionMetadata, error) {
+	version := &VaultVersionMetadata{}
+	if err := clients.DecodeData(data, version); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return version, nil
+}
+
+func metadataFromVault(data map[string]interface{}) (*VaultSecretMetadata, error) {
+	metadata := &VaultSecretMetadata{}
+	if err := clients.DecodeData(data, metadata); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	return metadata, nil
+}
+
+// encode prepares the data to be sent to vault, checked and set against the
+// version cas. A cas of 0 only lets the write through when the secret does
+// not exist yet.
+func encode(data map[string]string, cas int) map[string]interface{} {
+	return map[string]interface{}{
+		"data": data,
+		"options": map[string]interface{}{
+			"cas": cas,
+		},
+	}
+}
+
+// isUpToDate compares the secret as a whole: keys vault holds that are not
+// part of the managed resource are drift too. Values written by others as
+// something other than strings never match.
+func isUpToDate(data map[string]string, vaultData map[string]interface{}) bool {
+	if len(data) != len(vaultData) {
+		return false
+	}
+	for k, v := range data {
+		if s, ok := vaultData[k].(string); !ok || s != v {
+			return false
+		}
+	}
+	return true
+}
+
+func toMetaTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
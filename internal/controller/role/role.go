@@ -23,7 +23,6 @@ package role
 import (
 	"context"
 	"fmt"
-	"reflect"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -34,6 +33,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
 	apisv1alpha1 "github.com/topfreegames/crossplane-provider-vault/apis/v1alpha1"
@@ -154,12 +155,17 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	exists := err == nil && secret != nil
+	lateInitialized := false
 
 	if secret != nil {
 
-		crossplaneVault, _, _ := createVaultData(role)
-		vaultData := parseToCrossplane(secret.Data)
+		vaultData, err := parseToCrossplane(secret.Data)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRead)
+		}
+		lateInitialized = lateInitialize(&role.Spec.ForProvider, vaultData)
 
+		crossplaneVault, _, _ := createVaultData(role)
 		upToDate = isUpToDate(*crossplaneVault, *vaultData)
 
 		if exists && upToDate {
@@ -178,6 +184,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		// resource reconciler know that it needs to call Update.
 		ResourceUpToDate: upToDate,
 
+		// Return true when optional fields left unset in the managed resource
+		// were filled with the values Vault holds, so that the spec is
+		// persisted before the status.
+		ResourceLateInitialized: lateInitialized,
+
 		// Return any details that may be required to connect to the external
 		// resource. These will be stored as the connection secret.
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -251,7 +262,51 @@ func isUpToDate(crossplaneData, vaultData VaultRole) bool {
 	vaultData.Backend = crossplaneData.Backend
 	vaultData.RoleName = crossplaneData.RoleName
 
-	return reflect.DeepEqual(crossplaneData, vaultData)
+	// vault returns empty lists for fields that were never set, which are nil
+	// in the managed resource
+	return cmp.Equal(crossplaneData, vaultData, cmpopts.EquateEmpty())
+}
+
+// lateInitialize fills the optional parameters left unset in the managed
+// resource with the values Vault holds, usually server-side defaults. It
+// returns true when any parameter was filled.
+func lateInitialize(params *v1alpha1.RoleParameters, vaultData *VaultRole) bool {
+	li := false
+
+	if len(params.IamRolesArn) == 0 && len(vaultData.IamRolesArn) > 0 {
+		params.IamRolesArn = vaultData.IamRolesArn
+		li = true
+	}
+	if len(params.PoliciesArn) == 0 && len(vaultData.PoliciesArn) > 0 {
+		params.PoliciesArn = vaultData.PoliciesArn
+		li = true
+	}
+	if params.PolicyDocument == "" && vaultData.PolicyDocument != "" {
+		params.PolicyDocument = vaultData.PolicyDocument
+		li = true
+	}
+	if len(params.IamGroups) == 0 && len(vaultData.IamGroups) > 0 {
+		params.IamGroups = vaultData.IamGroups
+		li = true
+	}
+	if params.UserPath == "" && vaultData.UserPath != "" {
+		params.UserPath = vaultData.UserPath
+		li = true
+	}
+	if params.PermissionBoundaryArn == "" && vaultData.PermissionBoundaryArn != "" {
+		params.PermissionBoundaryArn = vaultData.PermissionBoundaryArn
+		li = true
+	}
+	if params.DefaultStsTTL == 0 && vaultData.DefaultStsTTL != 0 {
+		params.DefaultStsTTL = vaultData.DefaultStsTTL
+		li = true
+	}
+	if params.MaxStsTTL == 0 && vaultData.MaxStsTTL != 0 {
+		params.MaxStsTTL = vaultData.MaxStsTTL
+		li = true
+	}
+
+	return li
 }
 
 // writeRole add the defaults (if needed), validate the role and create it
@@ -18,6 +18,7 @@ package role
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -265,6 +266,55 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"late initializes server side defaults": {
+			reason: "unset optional fields must be filled from vault and the role be up to date",
+			fields: fields{
+				clientBuilder: func(t *testing.T) clients.VaultClient {
+
+					role := getTestRole()
+
+					name := meta.GetExternalName(role)
+					backend := role.Spec.ForProvider.Backend
+					path := backend + "/roles/" + name
+
+					secret := &api.Secret{
+						Data: map[string]interface{}{
+							"credential_type":          "assumed_role",
+							"role_arns":                []interface{}{"arn:aws:iam::123456789012:role/roletest"},
+							"policy_arns":              []interface{}{},
+							"policy_document":          fmtPolicyDocument(role.Spec.ForProvider.PolicyDocument),
+							"iam_groups":               []interface{}{},
+							"user_path":                "",
+							"permissions_boundary_arn": "",
+							"default_sts_ttl":          json.Number("3600"),
+							"max_sts_ttl":              json.Number("7200"),
+						},
+					}
+
+					ctrl := gomock.NewController(t)
+					logicalMock := fake.NewMockVaultLogicalClient(ctrl)
+
+					logicalMock.EXPECT().Read(path).Return(secret, nil)
+
+					clientMock := fake.NewMockVaultClient(ctrl)
+					clientMock.EXPECT().Logical().Return(logicalMock)
+					return clientMock
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				mg:  getTestRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       map[string][]byte{},
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
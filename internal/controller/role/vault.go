@@ -2,11 +2,12 @@ package role
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	"github.com/topfreegames/crossplane-provider-vault/apis/aws/v1alpha1"
+	"github.com/topfreegames/crossplane-provider-vault/internal/clients"
 )
 
 // Note: these values comes from https://registry.terraform.io/providers/hashicorp/vault/latest/docs/resources/aws_secret_backend_role
@@ -14,6 +15,7 @@ import (
 const (
 	errMarshal   = "error while parsing to json"
 	errUnmarshal = "error parsing json to interface"
+	errDecode    = "error decoding role returned by vault"
 )
 
 // VaultRole is a transport object to send to vault. The reason we are using it, its because vault only accepts values as snake_case
@@ -107,52 +109,11 @@ func decodeData(role *VaultRole) (map[string]interface{}, error) {
 	return d, nil
 }
 
-func parseToCrossplane(vaultData map[string]interface{}) *VaultRole {
-
-	return &VaultRole{
-		RoleName:              toString(vaultData, "role_name"),
-		Backend:               toString(vaultData, "backend"),
-		CredentialType:        toString(vaultData, "credential_type"),
-		IamRolesArn:           toStringSlices(vaultData, "role_arns"),
-		PoliciesArn:           toStringSlices(vaultData, "policy_arns"),
-		PolicyDocument:        toString(vaultData, "policy_document"),
-		IamGroups:             toStringSlices(vaultData, "iam_groups"),
-		UserPath:              toString(vaultData, "user_path"),
-		PermissionBoundaryArn: toString(vaultData, "permissions_boundary_arn"),
-		DefaultStsTTL:         toInt(vaultData, "default_sts_ttl"),
-		MaxStsTTL:             toInt(vaultData, "max_sts_ttl"),
-	}
-}
-
-// toStringSlices parse a array of object to array of strings
-func toStringSlices(data map[string]interface{}, field string) []string {
-
-	// crossplane unmentioned fields in the manifest are read as nil, so we
-	// should make the same for empty fields that comes from vault
-	if data[field] == nil {
-		return nil
-	}
-
-	dataInterface := data[field].([]interface{})
-	dataSlices := make([]string, len(dataInterface))
-	for i, v := range dataInterface {
-		dataSlices[i] = v.(string)
-	}
-
-	return dataSlices
-}
-
-// toString converts a json string object to string
-func toString(data map[string]interface{}, field string) string {
-	return fmt.Sprintf("%v", data[field])
-}
-
-// toInt converts a json number object to int
-func toInt(data map[string]interface{}, field string) int {
-	if data[field] == nil {
-		return 0
+// parseToCrossplane decodes the role returned by vault
+func parseToCrossplane(vaultData map[string]interface{}) (*VaultRole, error) {
+	role := &VaultRole{}
+	if err := clients.DecodeData(vaultData, role); err != nil {
+		return nil, errors.Wrap(err, errDecode)
 	}
-
-	f, _ := data[field].(json.Number).Int64()
-	return int(f)
+	return role, nil
 }
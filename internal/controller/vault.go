@@ -20,8 +20,41 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	authAppRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/approle"
+	authAppRoleSecretID "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/approlesecretid"
+	authAWSClientConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/awsclientconfig"
+	authAWSRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/awsrole"
+	authAWSSTSRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/awsstsrole"
+	authAzureBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/azurebackendconfig"
+	authAzureRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/azurerole"
+	authCertRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/certrole"
+	authGCPBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/gcpbackendconfig"
+	authGCPRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/gcprole"
+	authGitHubBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/githubbackendconfig"
+	authGitHubTeam "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/githubteam"
+	authGitHubUser "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/githubuser"
+	authJWTBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/jwtbackendconfig"
+	authKubernetesBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/kubernetesbackendconfig"
+	authKubernetesRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/kubernetesrole"
+	authLDAPBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/ldapbackendconfig"
+	authLDAPGroup "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/ldapgroup"
+	authLDAPUser "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/ldapuser"
+	authOktaBackendConfig "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/oktabackendconfig"
+	authOktaGroup "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/oktagroup"
+	authOktaUser "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/oktauser"
 	authRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/role"
+	authTokenRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/tokenrole"
+	authUserpassUser "github.com/topfreegames/crossplane-provider-vault/internal/controller/auth/userpassuser"
+	awsCredentials "github.com/topfreegames/crossplane-provider-vault/internal/controller/aws/credentials"
+	awsStaticRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/aws/staticrole"
 	"github.com/topfreegames/crossplane-provider-vault/internal/controller/config"
+	databaseConnection "github.com/topfreegames/crossplane-provider-vault/internal/controller/database/connection"
+	databaseRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/database/role"
+	databaseStaticRole "github.com/topfreegames/crossplane-provider-vault/internal/controller/database/staticrole"
+	kvSecretMetadata "github.com/topfreegames/crossplane-provider-vault/internal/controller/kv/secretmetadata"
+	kvSecretSync "github.com/topfreegames/crossplane-provider-vault/internal/controller/kv/secretsync"
+	kvSecretV1 "github.com/topfreegames/crossplane-provider-vault/internal/controller/kv/secretv1"
+	kvSecretV2 "github.com/topfreegames/crossplane-provider-vault/internal/controller/kv/secretv2"
 	"github.com/topfreegames/crossplane-provider-vault/internal/controller/policy"
 	"github.com/topfreegames/crossplane-provider-vault/internal/controller/role"
 )
@@ -34,6 +67,39 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		policy.Setup,
 		role.Setup,
 		authRole.Setup,
+		authJWTBackendConfig.Setup,
+		authKubernetesBackendConfig.Setup,
+		authKubernetesRole.Setup,
+		authAppRole.Setup,
+		authAppRoleSecretID.Setup,
+		authUserpassUser.Setup,
+		authLDAPBackendConfig.Setup,
+		authLDAPGroup.Setup,
+		authLDAPUser.Setup,
+		authTokenRole.Setup,
+		authCertRole.Setup,
+		authGitHubBackendConfig.Setup,
+		authGitHubTeam.Setup,
+		authGitHubUser.Setup,
+		authAWSClientConfig.Setup,
+		authAWSSTSRole.Setup,
+		authAWSRole.Setup,
+		authGCPBackendConfig.Setup,
+		authGCPRole.Setup,
+		authAzureBackendConfig.Setup,
+		authAzureRole.Setup,
+		authOktaBackendConfig.Setup,
+		authOktaGroup.Setup,
+		authOktaUser.Setup,
+		awsStaticRole.Setup,
+		awsCredentials.Setup,
+		kvSecretV2.Setup,
+		kvSecretMetadata.Setup,
+		kvSecretV1.Setup,
+		kvSecretSync.Setup,
+		databaseConnection.Setup,
+		databaseRole.Setup,
+		databaseStaticRole.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err